@@ -15,6 +15,9 @@ func newClusterResultsCMD() *cobra.Command {
 
 	cmd.AddCommand(clusterresults.NewListCMD())
 	cmd.AddCommand(clusterresults.NewSearchCMD())
+	cmd.AddCommand(clusterresults.NewSummaryCMD())
+	cmd.AddCommand(clusterresults.NewScoreCMD())
+	cmd.AddCommand(clusterresults.NewTopCMD())
 
 	return cmd
 }
@@ -4,52 +4,118 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/kyverno/policy-reporter-cli/pkg/cli"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
 	"github.com/kyverno/policy-reporter-cli/pkg/model"
 	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
 	"github.com/kyverno/policy-reporter-cli/pkg/utils"
 	"github.com/thediveo/klo"
 	"github.com/ttacon/chalk"
 )
 
-func grouingResults(ctx context.Context, results []policyreporter.PolicyReportResult, api policyreporter.API, apiFilter policyreporter.Filter) []*model.Group {
+func grouingResults(ctx context.Context, results []policyreporter.PolicyReportResult, api policyreporter.API, apiFilter policyreporter.Filter, groupings []cli.Grouping) []*model.Group {
 	result := apiFilter.Status
 	if len(result) == 0 {
 		result = policyreporter.AllResults
 	}
 
-	var groups []*model.Group
+	funcs := make([]utils.GroupingFunc, 0, len(groupings))
 
-	switch groupBy {
-	case cli.CategoryGrouping:
-		categories := apiFilter.Categories
-		if len(categories) == 0 {
-			categories, _ = api.Categories(ctx)
+	for _, grouping := range groupings {
+		if strings.HasPrefix(grouping, cli.PropertyGroupingPrefix) {
+			funcs = append(funcs, utils.GroupResultsByProperty(strings.TrimPrefix(grouping, cli.PropertyGroupingPrefix)))
+			continue
 		}
-		groups = utils.GroupResultsByCategory(results, categories)
-	case cli.PolicyGrouping:
-		policies := apiFilter.Policies
-		if len(policies) == 0 {
-			policies, _ = api.ClusterPolicies(ctx, apiFilter)
+
+		switch grouping {
+		case cli.CategoryGrouping:
+			categories := apiFilter.Categories
+			if len(categories) == 0 {
+				categories, _ = api.Categories(ctx)
+			}
+			funcs = append(funcs, func(list []policyreporter.PolicyReportResult) []*model.Group {
+				return utils.GroupResultsByCategory(list, categories)
+			})
+		case cli.PolicyGrouping:
+			policies := apiFilter.Policies
+			if len(policies) == 0 {
+				policies, _ = api.ClusterPolicies(ctx, apiFilter)
+			}
+			funcs = append(funcs, func(list []policyreporter.PolicyReportResult) []*model.Group {
+				return utils.GroupResultsByPolicy(list, policies)
+			})
+		case cli.ResourceGrouping:
+			funcs = append(funcs, utils.GroupResultsByResource)
+		case cli.NamespaceGrouping:
+			funcs = append(funcs, utils.GroupResultsByNamespace)
+		case cli.SeverityGrouping:
+			funcs = append(funcs, utils.GroupResultsBySeverity)
+		case cli.SourceGrouping:
+			funcs = append(funcs, utils.GroupResultsBySource)
+		case cli.KindGrouping:
+			funcs = append(funcs, utils.GroupResultsByKind)
+		case cli.RuleGrouping:
+			funcs = append(funcs, utils.GroupResultsByRule)
+		case cli.NoneGroup:
+			funcs = append(funcs, utils.NoneGrouping)
+		case cli.ResultGrouping:
+			funcs = append(funcs, func(list []policyreporter.PolicyReportResult) []*model.Group {
+				return utils.GroupResultsByResult(list, result)
+			})
 		}
-		groups = utils.GroupResultsByPolicy(results, policies)
-	case cli.ResourceGrouping:
-		groups = utils.GroupResultsByResource(results)
-	case cli.NoneGroup:
-		groups = utils.NoneGrouping(results)
-	default:
-		groups = utils.GroupResultsByResult(results, result)
 	}
 
-	return groups
+	return utils.NestedGrouping(results, funcs)
+}
+
+// fetchResults fetches the results of the filter, withSource fetches them per source to set the Source of each result
+func fetchResults(ctx context.Context, api policyreporter.API, filter policyreporter.Filter, withSource bool) (policyreporter.ResultList, error) {
+	if !withSource {
+		return api.ClusterResults(ctx, filter)
+	}
+
+	sources, err := api.ClusterSources(ctx)
+	if err != nil {
+		return policyreporter.ResultList{}, err
+	}
+
+	return policyreporter.ResultsWithSource(ctx, api.ClusterResults, filter, sources)
 }
 
-func buildTable(groups []*model.Group) {
+func groupedResults(groups []*model.Group) []policyreporter.PolicyReportResult {
+	list := make([]policyreporter.PolicyReportResult, 0)
+	for _, group := range groups {
+		list = append(list, group.List...)
+	}
+
+	return list
+}
+
+func buildTable(groups []*model.Group) error {
+	if output == cli.MarkdownOutput {
+		return render.Markdown(os.Stdout, groups, render.MarkdownOptions{
+			Title:   "Policy Reporter Cluster Results",
+			Details: markdownDetails,
+			MaxSize: markdownMaxSize,
+
+			Remediations: remediations.ForResults(groupedResults(groups)),
+		})
+	}
+
 	if len(groups) == 0 {
 		fmt.Println("No results found")
 
-		return
+		return nil
+	}
+
+	properties := ""
+	if showProperties {
+		properties = utils.PropertyColumns(utils.PropertyKeys(groupedResults(groups)))
+	} else if len(propertyColumns) > 0 {
+		properties = utils.PropertyColumns(propertyColumns)
 	}
 
 	for _, group := range groups {
@@ -59,23 +125,30 @@ func buildTable(groups []*model.Group) {
 			fmt.Println("")
 		}
 
-		prn, err := klo.PrinterFromFlag(output, &klo.Specs{
-			WideColumnSpec:    "KIND:{.Kind},NAME:{.Name},POLICY:{.Policy},RULE:{.Rule},SEVERITY:{.Severity},RESULT:{.Status},CREATED:{.TimeFormatted}",
-			DefaultColumnSpec: "KIND:{.Kind},NAME:{.Name},POLICY:{.Policy},RULE:{.Rule},RESULT:{.Status}",
-		})
+		replicas := ""
+		if ownerRollup {
+			replicas = ",REPLICAS:{.Replicas}"
+		}
+
+		prn, err := klo.PrinterFromFlag(columnView.Output, columnView.Specs(
+			"KIND:{.Kind},NAME:{.Name}"+replicas+",POLICY:{.Policy},RULE:{.Rule},RESULT:{.Status}"+properties,
+			"KIND:{.Kind},NAME:{.Name}"+replicas+",POLICY:{.Policy},RULE:{.Rule},SEVERITY:{.Severity},RESULT:{.Status},CREATED:{.TimeFormatted},REMEDIATION:{.Remediation}"+properties,
+		))
 		if err != nil {
-			fmt.Println(err)
+			return err
 		}
 
 		prn.Fprint(os.Stdout, group.List)
 	}
+
+	return nil
 }
 
 func generateFilterFromFlags() policyreporter.Filter {
 	filter := policyreporter.Filter{}
 
-	if source != "" {
-		filter.Sources = []string{source}
+	if len(sources) != 0 {
+		filter.Sources = sources
 	}
 	if len(results) != 0 {
 		filter.Status = results
@@ -86,14 +159,76 @@ func generateFilterFromFlags() policyreporter.Filter {
 	if len(policies) != 0 {
 		filter.Policies = policies
 	}
+	if len(severities) != 0 {
+		filter.Severities = severities
+	}
+	if len(resources) != 0 {
+		filter.Resources = resources
+	}
 
 	return filter
 }
 
+// applyClientFilter applies all filters which are not supported by the REST API and sorts the results
+func applyClientFilter(results policyreporter.ResultList) (policyreporter.ResultList, error) {
+	chain, err := clientFilter.Chain()
+	if err != nil {
+		return results, err
+	}
+
+	results = chain.Apply(results)
+
+	return results, utils.SortResults(results.Items, sortBy)
+}
+
+// applyResourceSelector keeps only results whose resources match the --selector, --field-selector and --annotation-selector
+func applyResourceSelector(ctx context.Context, resolver *config.Resolver, results policyreporter.ResultList) (policyreporter.ResultList, error) {
+	if resourceSelector.Empty() {
+		return results, nil
+	}
+
+	k8sClient, err := resolver.K8sClient()
+	if err != nil {
+		return results, err
+	}
+
+	return k8sClient.ResourceFilter(ctx, results, resourceSelector)
+}
+
+// applyOwnerRollup rolls up the results to the top level controllers of their resources if --owner-rollup is set
+func applyOwnerRollup(ctx context.Context, resolver *config.Resolver, results policyreporter.ResultList) (policyreporter.ResultList, error) {
+	if !ownerRollup {
+		return results, nil
+	}
+
+	k8sClient, err := resolver.K8sClient()
+	if err != nil {
+		return results, err
+	}
+
+	return k8sClient.OwnerRollup(ctx, results), nil
+}
+
+// applyRemediations sets the remediation guidance of the results, the catalogue is loaded once
+func applyRemediations(resolver *config.Resolver, results policyreporter.ResultList) error {
+	if remediations == nil {
+		catalogue, err := resolver.Remediations()
+		if err != nil {
+			return err
+		}
+
+		remediations = catalogue
+	}
+
+	remediations.Annotate(results.Items)
+
+	return nil
+}
+
 func generateSearchOptionsFromFlags() []string {
 	options := []string{}
 
-	if source == "" {
+	if len(sources) == 0 {
 		options = append(options, "Source")
 	}
 
@@ -109,7 +244,13 @@ func generateSearchOptionsFromFlags() []string {
 		options = append(options, "Kind")
 	}
 
-	options = append(options, "Resource", "Severity")
+	if len(resources) == 0 {
+		options = append(options, "Resource")
+	}
+
+	if len(severities) == 0 {
+		options = append(options, "Severity")
+	}
 
 	if len(results) == 0 {
 		options = append(options, "Result")
@@ -2,12 +2,23 @@ package clusterresults
 
 import (
 	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/kyverno/policy-reporter-cli/pkg/cli"
 	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/watch"
 	"github.com/spf13/cobra"
 )
 
-var labels string
+var (
+	watchResults  bool
+	watchInterval time.Duration
+)
 
 func NewListCMD() *cobra.Command {
 	cmd := &cobra.Command{
@@ -15,8 +26,26 @@ func NewListCMD() *cobra.Command {
 		Short: "List ClusterPolicyReportResults",
 		RunE: func(command *cobra.Command, args []string) error {
 			ctx := context.Background()
+			if watchResults {
+				var stop context.CancelFunc
+				ctx, stop = signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+				defer stop()
+			}
+
 			resolver := config.NewResolver(config.LoadConfig())
 
+			view, err := render.ResolveColumns(resolver.View(config.ClusterResultsView), output, columns, template)
+			if err != nil {
+				return err
+			}
+
+			columnView = view
+
+			groupings, err := cli.ParseGroupings(groupBy)
+			if err != nil {
+				return err
+			}
+
 			conn, err := resolver.ForwardPolicyReporter(ctx)
 			if err != nil {
 				return nil
@@ -24,26 +53,52 @@ func NewListCMD() *cobra.Command {
 			defer conn.Close()
 
 			api := resolver.API(conn.Port)
+
 			filter := generateFilterFromFlags()
-			results, err := api.ClusterResults(ctx, filter)
-			if err != nil {
-				return err
-			}
 
-			if labels != "" {
-				k8sClient, err := resolver.K8sClient()
-				if err == nil {
-					results = k8sClient.LabelFilter(ctx, results, labels)
+			fetch := func(ctx context.Context) (policyreporter.ResultList, error) {
+				results, err := fetchResults(ctx, api, filter, cli.HasGrouping(groupings, cli.SourceGrouping) || clientFilter.RequiresSource())
+				if err != nil {
+					return results, err
+				}
+
+				results, err = applyClientFilter(results)
+				if err != nil {
+					return results, err
+				}
+
+				results, err = applyResourceSelector(ctx, resolver, results)
+				if err != nil {
+					return results, err
+				}
+
+				results, err = applyOwnerRollup(ctx, resolver, results)
+				if err != nil {
+					return results, err
 				}
+
+				return results, applyRemediations(resolver, results)
 			}
 
-			buildTable(grouingResults(ctx, results.Items, api, filter))
+			show := func(results policyreporter.ResultList) error {
+				return buildTable(grouingResults(ctx, results.Items, api, filter, groupings))
+			}
+
+			if watchResults {
+				return watch.Run(ctx, watchInterval, fetch, show)
+			}
+
+			results, err := fetch(ctx)
+			if err != nil {
+				return err
+			}
 
-			return nil
+			return show(results)
 		},
 	}
 
-	cmd.Flags().StringVarP(&labels, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().BoolVarP(&watchResults, "watch", "w", false, "After listing the results, watch for changes and refresh the list")
+	cmd.Flags().DurationVar(&watchInterval, "interval", watch.DefaultInterval, "Refresh interval for --watch")
 
 	return sharedFlags(cmd)
 }
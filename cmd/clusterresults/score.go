@@ -0,0 +1,87 @@
+package clusterresults
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+)
+
+var (
+	scoreBy     string
+	scoreSort   string
+	minScore    float64
+	weighted    bool
+	weightFlags map[string]string
+)
+
+func NewScoreCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "score",
+		Short: "Compliance score (pass rate) of ClusterPolicyReportResults per policy, category, kind or cluster",
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			c := config.LoadConfig()
+			resolver := config.NewResolver(c)
+
+			var weights summary.Weights
+			if weighted || len(weightFlags) > 0 {
+				w, err := summary.ResolveWeights(c.Score.Weights, weightFlags)
+				if err != nil {
+					return err
+				}
+				weights = w
+			}
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			results, err := fetchResults(ctx, api, generateFilterFromFlags(), scoreBy == summary.SourceDimension || clientFilter.RequiresSource())
+			if err != nil {
+				return err
+			}
+
+			results, err = applyClientFilter(results)
+			if err != nil {
+				return err
+			}
+
+			scores, err := summary.Scores(results.Items, scoreBy, weights)
+			if err != nil {
+				return err
+			}
+
+			summary.SortScores(scores, scoreSort)
+
+			if err := render.Scores(os.Stdout, scores, scoreBy, output); err != nil {
+				return err
+			}
+
+			if below := summary.BelowMinimum(scores, minScore); len(below) > 0 {
+				command.SilenceUsage = true
+
+				return fmt.Errorf("%w: %d of %d scores are lower than %.2f", summary.ErrMinScore, len(below), len(scores), minScore)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&scoreBy, "by", summary.PolicyDimension, "Calculate the score per policy, category, kind, source, cluster")
+	cmd.Flags().StringVar(&scoreSort, "sort", summary.SortByScore, "Sort scores by score (lowest first) or name")
+	cmd.Flags().Float64Var(&minScore, "min-score", 0, "Exit with a non-zero code if any score is lower than this percentage")
+	cmd.Flags().BoolVar(&weighted, "weighted", false, "Weight each result by its severity (default weights: high=3,medium=2,low=1)")
+	cmd.Flags().StringToStringVar(&weightFlags, "weight", map[string]string{}, "Severity weights, implies --weighted (e.g. --weight high=5,medium=2)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|go-template|jsonpath")
+
+	return filterFlags(cmd)
+}
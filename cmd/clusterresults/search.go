@@ -7,8 +7,10 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/kyverno/policy-reporter-cli/pkg/cli"
 	"github.com/kyverno/policy-reporter-cli/pkg/config"
 	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
 	"github.com/spf13/cobra"
 	"github.com/ttacon/chalk"
 )
@@ -37,6 +39,18 @@ func NewSearchCMD() *cobra.Command {
 
 			resolver := config.NewResolver(config.LoadConfig())
 
+			view, err := render.ResolveColumns(resolver.View(config.ClusterResultsView), output, columns, template)
+			if err != nil {
+				return err
+			}
+
+			columnView = view
+
+			groupings, err := cli.ParseGroupings(groupBy)
+			if err != nil {
+				return err
+			}
+
 			conn, err := resolver.ForwardPolicyReporter(ctx)
 			if err != nil {
 				return nil
@@ -76,14 +90,32 @@ func NewSearchCMD() *cobra.Command {
 				}
 			}
 
-			results, err := api.ClusterResults(ctx, apiFilter)
+			results, err := fetchResults(ctx, api, apiFilter, cli.HasGrouping(groupings, cli.SourceGrouping) || clientFilter.RequiresSource())
+			if err != nil {
+				return err
+			}
+
+			results, err = applyClientFilter(results)
+			if err != nil {
+				return err
+			}
+
+			results, err = applyResourceSelector(ctx, resolver, results)
+			if err != nil {
+				return err
+			}
+
+			results, err = applyOwnerRollup(ctx, resolver, results)
 			if err != nil {
 				return err
 			}
 
-			buildTable(grouingResults(ctx, results.Items, api, apiFilter))
+			err = applyRemediations(resolver, results)
+			if err != nil {
+				return err
+			}
 
-			return nil
+			return buildTable(grouingResults(ctx, results.Items, api, apiFilter, groupings))
 		},
 	}
 
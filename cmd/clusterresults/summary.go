@@ -0,0 +1,60 @@
+package clusterresults
+
+import (
+	"context"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+)
+
+var (
+	dimension string
+	barWidth  int
+)
+
+func NewSummaryCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Summarize ClusterPolicyReportResults by result and policy, category, severity, source or kind",
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			filter := generateFilterFromFlags()
+
+			results, err := fetchResults(ctx, api, filter, dimension == summary.SourceDimension || clientFilter.RequiresSource())
+			if err != nil {
+				return err
+			}
+
+			results, err = applyClientFilter(results)
+			if err != nil {
+				return err
+			}
+
+			s, err := summary.Summarize(results.Items, dimension)
+			if err != nil {
+				return err
+			}
+
+			return render.Summary(os.Stdout, s, output, barWidth)
+		},
+	}
+
+	cmd.Flags().StringVar(&dimension, "by", summary.PolicyDimension, "Count PolicyReportResults by policy, category, severity, source, kind, resource, rule")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|bar|go-template|jsonpath")
+	cmd.Flags().IntVar(&barWidth, "bar-width", render.DefaultBarWidth, "Width of the longest bar in characters for bar output")
+
+	return filterFlags(cmd)
+}
@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/k8s"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/thediveo/klo"
+)
+
+var (
+	describeNamespace string
+	describeCluster   bool
+	describeRelated   bool
+	describeOutput    string
+)
+
+func newDescribeCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <kind>/<name>",
+		Short: "Show all PolicyReportResults of a single resource and its owner and child resources",
+		Example: `  pr describe deployment/nginx -n default
+  pr describe namespace/default --cluster`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return completion.FromAPI(false, completion.Resources)(cmd, args, toComplete)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kind, name, ok := strings.Cut(args[0], "/")
+			if !ok || kind == "" || name == "" {
+				return fmt.Errorf("invalid resource %q, expected <kind>/<name>", args[0])
+			}
+
+			ctx := context.Background()
+
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			namespace := describeNamespace
+			if describeCluster {
+				namespace = ""
+			} else if namespace == "" {
+				namespace, err = resolver.CurrentNamespace()
+				if err != nil {
+					return err
+				}
+			}
+
+			fetch, listSources, filter := api.Results, api.Sources, policyreporter.Filter{Namespaces: []string{namespace}}
+			if describeCluster {
+				fetch, listSources, filter = api.ClusterResults, api.ClusterSources, policyreporter.Filter{}
+			}
+
+			sources, err := listSources(ctx)
+			if err != nil {
+				return err
+			}
+
+			results, err := policyreporter.ResultsWithSource(ctx, fetch, filter, sources)
+			if err != nil {
+				return err
+			}
+
+			if err := utils.SortResults(results.Items, ""); err != nil {
+				return err
+			}
+
+			description := render.Description{Kind: kind, Name: name, Namespace: namespace}
+
+			others := make(map[string][]policyreporter.PolicyReportResult, 0)
+			keys := make([]string, 0)
+
+			for _, result := range results.Items {
+				if strings.EqualFold(result.Kind, kind) && result.Name == name {
+					description.Kind = result.Kind
+					description.APIVersion = result.APIVersion
+					description.Results = append(description.Results, result)
+					continue
+				}
+
+				key := k8s.ResourceKey(result)
+				if _, ok := others[key]; !ok {
+					keys = append(keys, key)
+				}
+
+				others[key] = append(others[key], result)
+			}
+
+			catalogue, err := resolver.Remediations()
+			if err != nil {
+				return err
+			}
+
+			catalogue.Annotate(description.Results)
+			description.Remediations = catalogue.ForResults(description.Results)
+
+			if describeRelated {
+				k8sClient, err := resolver.K8sClient()
+				if err != nil {
+					return err
+				}
+
+				description.Related = relatedResources(ctx, k8sClient, description, keys, others)
+			}
+
+			if len(description.Results) == 0 && len(description.Related) == 0 {
+				fmt.Printf("No results found for %s\n", args[0])
+				return nil
+			}
+
+			if describeOutput != "" {
+				prn, err := klo.PrinterFromFlag(describeOutput, nil)
+				if err != nil {
+					return err
+				}
+
+				return prn.Fprint(os.Stdout, description)
+			}
+
+			return render.Describe(os.Stdout, description)
+		},
+	}
+
+	cmd.Flags().StringVarP(&describeNamespace, "namespace", "n", "", "Namespace of the resource, defaults to the current namespace")
+	cmd.Flags().BoolVar(&describeCluster, "cluster", false, "Describe a cluster scoped resource")
+	cmd.Flags().BoolVar(&describeRelated, "related", true, "Show the results of owner and child resources, requires access to the Kubernetes API and costs one GET request per owner and per Pod, ReplicaSet or Job with results in the namespace")
+	cmd.Flags().StringVarP(&describeOutput, "output", "o", "", "Output format. One of: yaml|json")
+
+	cmd.RegisterFlagCompletionFunc("namespace", completion.FromAPI(false, completion.Namespaces))
+	cmd.RegisterFlagCompletionFunc("output", completion.Static("json", "yaml"))
+
+	return cmd
+}
+
+// childKinds are the kinds which are controlled by Pod controllers, only resources of these kinds are checked for child resources
+var childKinds = map[string]bool{"Pod": true, "ReplicaSet": true, "Job": true}
+
+// relatedResources resolves the owners of the described resource and all resources of the results which are controlled by it.
+// Controllers are fetched once per resource, child resources are only resolved for resources of childKinds.
+func relatedResources(ctx context.Context, client k8s.Client, description render.Description, keys []string, others map[string][]policyreporter.PolicyReportResult) []render.RelatedResource {
+	related := make([]render.RelatedResource, 0)
+	controllers := make(map[string]*k8s.Owner, 0)
+
+	owners := func(namespace string, resource k8s.Owner) ([]k8s.Owner, error) {
+		chain := make([]k8s.Owner, 0)
+
+		for i := 0; i < k8s.MaxOwnerDepth; i++ {
+			key := fmt.Sprintf("%s/%s/%s/%s", namespace, resource.APIVersion, resource.Kind, resource.Name)
+
+			controller, ok := controllers[key]
+			if !ok {
+				var err error
+				controller, err = client.Controller(ctx, namespace, resource)
+				if err != nil {
+					return chain, err
+				}
+
+				controllers[key] = controller
+			}
+
+			if controller == nil {
+				break
+			}
+
+			resource = *controller
+			chain = append(chain, resource)
+		}
+
+		return chain, nil
+	}
+
+	find := func(kind, name string) []policyreporter.PolicyReportResult {
+		for _, key := range keys {
+			results := others[key]
+			if results[0].Kind == kind && results[0].Name == name {
+				return results
+			}
+		}
+
+		return nil
+	}
+
+	if description.APIVersion != "" {
+		chain, err := owners(description.Namespace, k8s.Owner{APIVersion: description.APIVersion, Kind: description.Kind, Name: description.Name})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[WARNING] %s, owners may be incomplete\n", err)
+		}
+
+		for _, owner := range chain {
+			related = append(related, render.RelatedResource{
+				Relation: render.OwnerRelation,
+				Kind:     owner.Kind,
+				Name:     owner.Name,
+				Results:  find(owner.Kind, owner.Name),
+			})
+		}
+	}
+
+	if strings.EqualFold(description.Kind, "Pod") {
+		return related
+	}
+
+	failed := make(map[string]bool, 0)
+
+	for _, key := range keys {
+		results := others[key]
+		if !childKinds[results[0].Kind] {
+			continue
+		}
+
+		resource := k8s.Owner{APIVersion: results[0].APIVersion, Kind: results[0].Kind, Name: results[0].Name}
+
+		chain, err := owners(results[0].Namespace, resource)
+		if err != nil && !failed[resource.Kind] {
+			failed[resource.Kind] = true
+			fmt.Fprintf(os.Stderr, "[WARNING] %s, child resources of kind %s may be incomplete\n", err, resource.Kind)
+		}
+
+		for _, owner := range chain {
+			if strings.EqualFold(owner.Kind, description.Kind) && owner.Name == description.Name {
+				related = append(related, render.RelatedResource{
+					Relation: render.ChildRelation,
+					Kind:     resource.Kind,
+					Name:     resource.Name,
+					Results:  results,
+				})
+				break
+			}
+		}
+	}
+
+	return related
+}
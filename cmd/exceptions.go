@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"github.com/kyverno/policy-reporter-cli/cmd/exceptions"
+	"github.com/spf13/cobra"
+)
+
+func newExceptionsCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "exceptions",
+		Aliases: []string{"exc"},
+		Short:   "Generate Kyverno PolicyExceptions from PolicyReportResults",
+	}
+
+	cmd.AddCommand(exceptions.NewGenerateCMD())
+
+	return cmd
+}
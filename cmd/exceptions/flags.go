@@ -0,0 +1,146 @@
+package exceptions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/clientfilter"
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	clusterScope      bool
+	allNamespaces     bool
+	namespaceSelector string
+
+	namespaces []string
+	sources    []string
+	results    []string
+	categories []string
+	kinds      []string
+	policies   []string
+	severities []string
+	resources  []string
+
+	clientFilter clientfilter.Options
+)
+
+var (
+	// ErrNoMatchingNamespaces is returned if no namespace matches the namespace selector
+	ErrNoMatchingNamespaces = errors.New("No namespaces matching the namespace selector")
+	// ErrNamespaceFlags is returned if namespace filters are combined with cluster scoped results
+	ErrNamespaceFlags = errors.New("--namespace, --all-namespaces and --namespace-selector are not supported with --cluster")
+)
+
+// filterFlags registers the flags to filter the PolicyReportResults exceptions are generated for
+func filterFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().BoolVar(&clusterScope, "cluster", false, "Generate PolicyExceptions for cluster scoped PolicyReportResults")
+	cmd.Flags().StringArrayVarP(&namespaces, "namespace", "n", []string{}, "If present, the namespace scope for this CLI request, repeat the flag for multiple namespaces")
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "If present, search results across all namespaces.")
+	cmd.Flags().StringVar(&namespaceSelector, "namespace-selector", "", "Selector (label query) for the namespaces to search results in (e.g. --namespace-selector team=payments)")
+	cmd.Flags().StringArrayVarP(&sources, "source", "s", []string{}, "Filter PolicyReportResults by source")
+	cmd.Flags().StringArrayVar(&results, "result", []string{}, "Filter PolicyReportResults by result")
+	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", []string{}, "Filter PolicyReportResults by kinds (only fullqualified singular kind names are supported)")
+	cmd.Flags().StringArrayVar(&categories, "category", []string{}, "Filter PolicyReportResults by category")
+	cmd.Flags().StringArrayVar(&policies, "policy", []string{}, "Filter PolicyReportResults by policy name")
+	cmd.Flags().StringArrayVar(&severities, "severity", []string{}, "Filter PolicyReportResults by severity")
+	cmd.Flags().StringArrayVar(&resources, "resource", []string{}, "Filter PolicyReportResults by resource name")
+
+	clientfilter.AddFlags(cmd.Flags(), &clientFilter, true)
+
+	completion.RegisterFlags(cmd, false)
+
+	return cmd
+}
+
+// generateFilterFromFlags creates the API filter, the current namespace is used for namespaced results if no namespace flag is set
+func generateFilterFromFlags(currentNamespace string) policyreporter.Filter {
+	filter := policyreporter.Filter{
+		Sources:    sources,
+		Status:     results,
+		Categories: categories,
+		Kinds:      kinds,
+		Policies:   policies,
+		Severities: severities,
+		Resources:  resources,
+	}
+
+	if clusterScope {
+		return filter
+	}
+
+	if len(namespaces) != 0 {
+		filter.Namespaces = namespaces
+	} else if allNamespaces || namespaceSelector != "" || len(clientFilter.NamespacePatterns) > 0 {
+		filter.Namespaces = []string{}
+	} else if currentNamespace != "" {
+		filter.Namespaces = []string{currentNamespace}
+	}
+
+	return filter
+}
+
+// applyNamespaceSelector restricts the namespaces of the filter to the namespaces matching the --namespace-selector
+func applyNamespaceSelector(ctx context.Context, resolver *config.Resolver, filter *policyreporter.Filter) error {
+	if namespaceSelector == "" {
+		return nil
+	}
+
+	k8sClient, err := resolver.K8sClient()
+	if err != nil {
+		return err
+	}
+
+	selected, err := k8sClient.Namespaces(ctx, namespaceSelector)
+	if err != nil {
+		return err
+	}
+
+	if len(filter.Namespaces) > 0 {
+		selected = intersect(selected, filter.Namespaces)
+	}
+
+	if len(selected) == 0 {
+		return ErrNoMatchingNamespaces
+	}
+
+	filter.Namespaces = selected
+
+	return nil
+}
+
+// fetchResults fetches the namespaced or cluster scoped results, per source if the query uses the source
+func fetchResults(ctx context.Context, api policyreporter.API, filter policyreporter.Filter) (policyreporter.ResultList, error) {
+	fetch, list := api.Results, api.Sources
+	if clusterScope {
+		fetch, list = api.ClusterResults, api.ClusterSources
+	}
+
+	if !clientFilter.RequiresSource() {
+		return fetch(ctx, filter)
+	}
+
+	available, err := list(ctx)
+	if err != nil {
+		return policyreporter.ResultList{}, err
+	}
+
+	return policyreporter.ResultsWithSource(ctx, fetch, filter, available)
+}
+
+func intersect(values, allowed []string) []string {
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		for _, a := range allowed {
+			if a == value {
+				list = append(list, value)
+				break
+			}
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,209 @@
+package exceptions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/kyverno/policy-reporter-cli/pkg/clientfilter"
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/exception"
+	"github.com/kyverno/policy-reporter-cli/pkg/k8s"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+	"github.com/ttacon/chalk"
+)
+
+var (
+	exceptionOptions = exception.Options{}
+	expires          string
+	outputDir        string
+	interactive      bool
+)
+
+func NewGenerateCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate Kyverno PolicyExceptions for the filtered PolicyReportResults",
+		Example: `  pr exceptions generate -n default --policy disallow-privileged-containers --expires 30d
+  pr exceptions generate --cluster --policy require-ns-labels --exception-namespace kyverno`,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			if clusterScope && (len(namespaces) > 0 || allNamespaces || namespaceSelector != "") {
+				return ErrNamespaceFlags
+			}
+
+			if clusterScope && exceptionOptions.Scope == exception.NamespaceScope {
+				return fmt.Errorf("--per %s is not supported with --cluster", exception.NamespaceScope)
+			}
+
+			if expires != "" {
+				expiry, err := parseExpiry(expires, time.Now())
+				if err != nil {
+					return err
+				}
+
+				exceptionOptions.Expires = expiry
+			}
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			ns := ""
+			if !clusterScope {
+				ns, err = resolver.CurrentNamespace()
+				if err != nil {
+					return err
+				}
+			}
+
+			filter := generateFilterFromFlags(ns)
+			if len(filter.Status) == 0 {
+				filter.Status = summary.OffenderResults
+			}
+
+			err = applyNamespaceSelector(ctx, resolver, &filter)
+			if err == ErrNoMatchingNamespaces {
+				fmt.Println("No results found")
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			results, err := fetchResults(ctx, api, filter)
+			if err != nil {
+				return err
+			}
+
+			chain, err := clientFilter.Chain()
+			if err != nil {
+				return err
+			}
+
+			results = chain.Apply(results)
+
+			if interactive {
+				results, err = selectResults(results)
+				if err == terminal.InterruptErr {
+					fmt.Println("")
+					fmt.Println(chalk.Red, chalk.Bold.TextStyle("Selection interrupted"))
+					fmt.Println("")
+					return nil
+				} else if err != nil {
+					return err
+				}
+			}
+
+			if len(results.Items) == 0 {
+				fmt.Println("No results found")
+				return nil
+			}
+
+			var labels exception.LabelFunc
+			if exceptionOptions.Match == exception.LabelMatch {
+				k8sClient, err := resolver.K8sClient()
+				if err != nil {
+					return err
+				}
+
+				labels = func(result policyreporter.PolicyReportResult) (map[string]string, error) {
+					return k8sClient.Labels(ctx, result.Namespace, k8s.Owner{APIVersion: result.APIVersion, Kind: result.Kind, Name: result.Name})
+				}
+			}
+
+			exceptions, err := exception.Generate(results.Items, labels, exceptionOptions)
+			if err != nil {
+				return err
+			}
+
+			if outputDir == "" {
+				return exception.Write(os.Stdout, exceptions)
+			}
+
+			files, err := exception.WriteFiles(outputDir, exceptions)
+			for _, file := range files {
+				fmt.Printf("%s written\n", file)
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&exceptionOptions.Scope, "per", exception.ResourceScope, "Create one PolicyException per resource or per namespace")
+	cmd.Flags().StringVar(&exceptionOptions.Match, "match", exception.NameMatch, "Match the resources by names or by labels, labels requires --label-key")
+	cmd.Flags().StringArrayVar(&exceptionOptions.LabelKeys, "label-key", []string{}, "Label used to match the resources with --match labels, the value is read from the resource")
+	cmd.Flags().StringVar(&exceptionOptions.Namespace, "exception-namespace", "", "Namespace of the PolicyExceptions, defaults to the namespace of the resources, set it for cluster scoped resources")
+	cmd.Flags().StringVar(&exceptionOptions.NamePrefix, "name-prefix", "polr-", "Prefix of the PolicyException names")
+	cmd.Flags().StringVar(&exceptionOptions.APIVersion, "api-version", exception.DefaultAPIVersion, "APIVersion of the PolicyExceptions")
+	cmd.Flags().StringVar(&exceptionOptions.ExpiryAnnotation, "expiry-annotation", exception.DefaultExpiryAnnotation, "Annotation to store the expiry time")
+	cmd.Flags().StringVar(&expires, "expires", "", "Expiry of the PolicyExceptions as duration from now (e.g. 30d) or RFC3339 time")
+	cmd.Flags().StringVar(&outputDir, "output-dir", "", "Write each PolicyException into a separate file in the directory instead of stdout")
+	cmd.Flags().BoolVarP(&interactive, "interactive", "i", false, "Select the PolicyReportResults to generate PolicyExceptions for interactively")
+
+	cmd.RegisterFlagCompletionFunc("per", completion.Static(exception.ResourceScope, exception.NamespaceScope))
+	cmd.RegisterFlagCompletionFunc("match", completion.Static(exception.NameMatch, exception.LabelMatch))
+
+	return filterFlags(cmd)
+}
+
+// parseExpiry parses a RFC3339 time or a duration from now
+func parseExpiry(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	duration, err := clientfilter.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %q, expected a duration like '30d' or a RFC3339 time", value)
+	}
+
+	return now.Add(duration), nil
+}
+
+func selectResults(results policyreporter.ResultList) (policyreporter.ResultList, error) {
+	if len(results.Items) == 0 {
+		return results, nil
+	}
+
+	options := make([]string, 0, len(results.Items))
+	for _, result := range results.Items {
+		resource := fmt.Sprintf("%s/%s", result.Kind, result.Name)
+		if result.Namespace != "" {
+			resource = fmt.Sprintf("%s/%s", result.Namespace, resource)
+		}
+
+		options = append(options, fmt.Sprintf("%s: %s/%s (%s)", resource, result.Policy, result.Rule, result.Status))
+	}
+
+	selected := []int{}
+
+	prompt := &survey.MultiSelect{
+		Message:  "Select Results:",
+		Options:  options,
+		PageSize: 15,
+	}
+
+	err := survey.AskOne(prompt, &selected)
+	if err != nil {
+		return results, err
+	}
+
+	items := make([]policyreporter.PolicyReportResult, 0, len(selected))
+	for _, index := range selected {
+		items = append(items, results.Items[index])
+	}
+
+	return policyreporter.ResultList{Items: items, Count: len(items)}, nil
+}
@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/fix"
+	"github.com/kyverno/policy-reporter-cli/pkg/k8s"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	fixNamespaces    []string
+	fixAllNamespaces bool
+	fixPolicies      []string
+	fixKinds         []string
+	fixResources     []string
+	fixOutput        string
+	fixDryRun        bool
+	fixOutputDir     string
+)
+
+// ErrMissingOutputDir is returned if patched manifests should be written without a target directory
+var ErrMissingOutputDir = errors.New("--dry-run=false requires --output-dir, patches are never applied to the cluster")
+
+func newFixCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fix",
+		Short: "Compute patches for failing Pod Security rules with a mechanical fix, patches are never applied to the cluster",
+		Long: fmt.Sprintf(`Compute patches for failing Pod Security rules with a mechanical fix.
+
+The current manifests of the failing resources are fetched from the Kubernetes API and patched locally.
+Resources controlled by another resource, like Pods of a Deployment, are skipped in favor of their controller.
+The fields of standalone Pods are immutable, so they are always printed as diff and have to be recreated.
+Patches are never applied to the cluster, use --dry-run=false --output-dir to write the patched manifests to disk.
+
+Supported policies: %s`, strings.Join(fix.Policies(), ", ")),
+		Example: `  pr fix --dry-run -n default
+  pr fix -A --policy disallow-privilege-escalation -o patch
+  pr fix -n default --dry-run=false --output-dir ./patched`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fixOutput != "diff" && fixOutput != "patch" {
+				return fmt.Errorf("unsupported output %q, expected one of: diff, patch", fixOutput)
+			}
+
+			if !fixDryRun && fixOutputDir == "" {
+				return ErrMissingOutputDir
+			}
+
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			filter := policyreporter.Filter{
+				Policies:  fix.Policies(),
+				Kinds:     fixKinds,
+				Resources: fixResources,
+				Status:    summary.OffenderResults,
+			}
+
+			if len(fixPolicies) > 0 {
+				filter.Policies = fixPolicies
+			}
+
+			if len(fixNamespaces) > 0 {
+				filter.Namespaces = fixNamespaces
+			} else if !fixAllNamespaces {
+				ns, err := resolver.CurrentNamespace()
+				if err != nil {
+					return err
+				}
+
+				filter.Namespaces = []string{ns}
+			}
+
+			results, err := api.Results(ctx, filter)
+			if err != nil {
+				return err
+			}
+
+			resources := make(map[string][]policyreporter.PolicyReportResult, 0)
+			keys := make([]string, 0)
+
+			for _, result := range results.Items {
+				if _, ok := fix.Lookup(result); !ok {
+					continue
+				}
+
+				key := k8s.ResourceKey(result)
+				if _, ok := resources[key]; !ok {
+					keys = append(keys, key)
+				}
+
+				resources[key] = append(resources[key], result)
+			}
+
+			if len(keys) == 0 {
+				fmt.Println("No fixable results found")
+				return nil
+			}
+
+			k8sClient, err := resolver.K8sClient()
+			if err != nil {
+				return err
+			}
+
+			for _, key := range keys {
+				if err := fixResource(ctx, k8sClient, resources[key]); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&fixNamespaces, "namespace", "n", []string{}, "If present, the namespace scope for this CLI request, repeat the flag for multiple namespaces")
+	cmd.Flags().BoolVarP(&fixAllNamespaces, "all-namespaces", "A", false, "If present, use results across all namespaces.")
+	cmd.Flags().StringArrayVar(&fixPolicies, "policy", []string{}, "Only fix the given policies, defaults to all supported policies")
+	cmd.Flags().StringArrayVarP(&fixKinds, "kind", "k", []string{}, "Filter PolicyReportResults by kinds (only fullqualified singular kind names are supported)")
+	cmd.Flags().StringArrayVar(&fixResources, "resource", []string{}, "Filter PolicyReportResults by resource name")
+	cmd.Flags().StringVarP(&fixOutput, "output", "o", "diff", "Output format. One of: diff|patch, patch prints the JSON patches as kubectl patch commands, Pods are always printed as diff")
+	cmd.Flags().BoolVar(&fixDryRun, "dry-run", true, "Only print the patches, --dry-run=false writes the patched manifests to --output-dir")
+	cmd.Flags().StringVar(&fixOutputDir, "output-dir", "", "Directory for the patched manifests, requires --dry-run=false")
+
+	cmd.RegisterFlagCompletionFunc("namespace", completion.FromAPI(false, completion.Namespaces))
+	cmd.RegisterFlagCompletionFunc("policy", completion.Static(fix.Policies()...))
+	cmd.RegisterFlagCompletionFunc("kind", completion.FromAPI(false, completion.Kinds))
+	cmd.RegisterFlagCompletionFunc("resource", completion.FromAPI(false, completion.Resources))
+	cmd.RegisterFlagCompletionFunc("output", completion.Static("diff", "patch"))
+
+	return cmd
+}
+
+// fixResource computes the patch for the results of a single resource and prints or writes it
+func fixResource(ctx context.Context, client k8s.Client, results []policyreporter.PolicyReportResult) error {
+	result := results[0]
+	name := fmt.Sprintf("%s/%s/%s", result.Namespace, result.Kind, result.Name)
+
+	item, err := client.Get(ctx, result.Namespace, k8s.Owner{APIVersion: result.APIVersion, Kind: result.Kind, Name: result.Name})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "[WARNING] %s, %s skipped\n", err, name)
+		return nil
+	}
+
+	if owner := v1.GetControllerOf(item); owner != nil {
+		fmt.Fprintf(os.Stderr, "[INFO] %s is controlled by %s %s, skipped\n", name, owner.Kind, owner.Name)
+		return nil
+	}
+
+	manifest := fix.Clean(item.Object)
+
+	patch, err := fix.Compute(manifest, results)
+	if errors.Is(err, fix.ErrUnsupportedKind) {
+		fmt.Fprintf(os.Stderr, "[WARNING] %s, %s skipped\n", err, name)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(patch.Operations) == 0 {
+		return nil
+	}
+
+	fmt.Printf("# %s\n", strings.Join(patch.Rules, ", "))
+
+	if result.Kind == "Pod" {
+		fmt.Printf("# the fields of a Pod are immutable, recreate %s with the patched manifest\n", name)
+	}
+
+	if fixOutput == "patch" && result.Kind != "Pod" {
+		content, err := json.Marshal(patch.Operations)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("kubectl patch %s %s -n %s --type json -p '%s'\n", strings.ToLower(result.Kind), result.Name, result.Namespace, content)
+	} else {
+		before, err := yaml.Marshal(manifest)
+		if err != nil {
+			return err
+		}
+
+		after, err := yaml.Marshal(patch.Patched)
+		if err != nil {
+			return err
+		}
+
+		fix.Diff(os.Stdout, name, string(before), string(after))
+	}
+
+	fmt.Println("")
+
+	if fixDryRun {
+		return nil
+	}
+
+	content, err := yaml.Marshal(patch.Patched)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(fixOutputDir, 0o755); err != nil {
+		return err
+	}
+
+	file := filepath.Join(fixOutputDir, strings.ToLower(fmt.Sprintf("%s-%s-%s.yaml", result.Namespace, result.Kind, result.Name)))
+	if err := os.WriteFile(file, content, 0o644); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s written\n\n", file)
+
+	return nil
+}
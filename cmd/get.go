@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/kyverno/policy-reporter-cli/cmd/get"
+	"github.com/spf13/cobra"
+)
+
+func newGetCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "List the distinct categories, sources, kinds, namespaces or resources of (Cluster)PolicyReportResults",
+	}
+
+	cmd.AddCommand(get.NewCategoriesCMD())
+	cmd.AddCommand(get.NewSourcesCMD())
+	cmd.AddCommand(get.NewKindsCMD())
+	cmd.AddCommand(get.NewNamespacesCMD())
+	cmd.AddCommand(get.NewResourcesCMD())
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package get
+
+import (
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	clusterScope  bool
+	allNamespaces bool
+
+	namespaces []string
+	sources    []string
+	results    []string
+	categories []string
+	kinds      []string
+	policies   []string
+	severities []string
+	output     string
+)
+
+// outputFlags registers the output flag and, if cluster is true, the flag to use cluster scoped PolicyReportResults
+func outputFlags(cmd *cobra.Command, cluster bool) *cobra.Command {
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|go-template|jsonpath")
+
+	if cluster {
+		cmd.Flags().BoolVar(&clusterScope, "cluster", false, "Use cluster scoped PolicyReportResults")
+	}
+
+	return cmd
+}
+
+// filterFlags registers the flags to filter the PolicyReportResults the values are collected from
+func filterFlags(cmd *cobra.Command) *cobra.Command {
+	outputFlags(cmd, true)
+	cmd.Flags().StringArrayVarP(&namespaces, "namespace", "n", []string{}, "If present, the namespace scope for this CLI request, repeat the flag for multiple namespaces")
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "If present, use results across all namespaces.")
+	cmd.Flags().StringArrayVarP(&sources, "source", "s", []string{}, "Filter PolicyReportResults by source")
+	cmd.Flags().StringArrayVar(&results, "result", []string{}, "Filter PolicyReportResults by result")
+	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", []string{}, "Filter PolicyReportResults by kinds (only fullqualified singular kind names are supported)")
+	cmd.Flags().StringArrayVar(&categories, "category", []string{}, "Filter PolicyReportResults by category")
+	cmd.Flags().StringArrayVar(&policies, "policy", []string{}, "Filter PolicyReportResults by policy name")
+	cmd.Flags().StringArrayVar(&severities, "severity", []string{}, "Filter PolicyReportResults by severity")
+
+	completion.RegisterFlags(cmd, false)
+
+	return cmd
+}
+
+// generateFilterFromFlags creates the API filter, the current namespace is used if no namespace flag is set and currentNamespace is true
+func generateFilterFromFlags(resolver *config.Resolver, currentNamespace bool) (policyreporter.Filter, error) {
+	filter := policyreporter.Filter{
+		Sources:    sources,
+		Status:     results,
+		Kinds:      kinds,
+		Categories: categories,
+		Policies:   policies,
+		Severities: severities,
+	}
+
+	if clusterScope || allNamespaces {
+		return filter, nil
+	}
+
+	if len(namespaces) > 0 {
+		filter.Namespaces = namespaces
+		return filter, nil
+	}
+
+	if !currentNamespace {
+		return filter, nil
+	}
+
+	ns, err := resolver.CurrentNamespace()
+	if err != nil {
+		return filter, err
+	}
+
+	if ns != "" {
+		filter.Namespaces = []string{ns}
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,171 @@
+package get
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/spf13/cobra"
+	"github.com/thediveo/klo"
+)
+
+// ErrNamespacedOnly is returned for values which only exist for namespace scoped results
+var ErrNamespacedOnly = errors.New("namespaces are not available for cluster scoped results")
+
+// FetchFunc loads the values of a get command from the API
+type FetchFunc = func(ctx context.Context, api policyreporter.API, filter policyreporter.Filter) (interface{}, error)
+
+// ListFunc loads all values of a get command without filter from the API
+type ListFunc = func(ctx context.Context, api policyreporter.API) (interface{}, error)
+
+type value struct {
+	Name string `json:"name"`
+}
+
+func NewCategoriesCMD() *cobra.Command {
+	return newListCMD("categories", "List all categories", "CATEGORY:{.Name}", false, func(ctx context.Context, api policyreporter.API) (interface{}, error) {
+		return api.Categories(ctx)
+	})
+}
+
+func NewSourcesCMD() *cobra.Command {
+	return newListCMD("sources", "List all sources", "SOURCE:{.Name}", true, func(ctx context.Context, api policyreporter.API) (interface{}, error) {
+		if clusterScope {
+			return api.ClusterSources(ctx)
+		}
+
+		return api.Sources(ctx)
+	})
+}
+
+func NewKindsCMD() *cobra.Command {
+	return newGetCMD("kinds", "List all kinds of the filtered PolicyReportResults", "KIND:{.Name}", true, func(ctx context.Context, api policyreporter.API, filter policyreporter.Filter) (interface{}, error) {
+		if clusterScope {
+			return api.ClusterKinds(ctx, filter)
+		}
+
+		return api.Kinds(ctx, filter)
+	})
+}
+
+func NewNamespacesCMD() *cobra.Command {
+	return newGetCMD("namespaces", "List all namespaces of the filtered PolicyReportResults", "NAMESPACE:{.Name}", false, func(ctx context.Context, api policyreporter.API, filter policyreporter.Filter) (interface{}, error) {
+		if clusterScope {
+			return nil, ErrNamespacedOnly
+		}
+
+		return api.Namespaces(ctx, filter)
+	})
+}
+
+func NewResourcesCMD() *cobra.Command {
+	return newGetCMD("resources", "List all resources of the filtered PolicyReportResults", "KIND:{.Kind},NAME:{.Name}", true, func(ctx context.Context, api policyreporter.API, filter policyreporter.Filter) (interface{}, error) {
+		if clusterScope {
+			return api.ClusterResources(ctx, filter)
+		}
+
+		return api.Resources(ctx, filter)
+	})
+}
+
+// newGetCMD creates a command printing the fetched values with the columns spec, string values are printed as {.Name}
+func newGetCMD(use, short, columns string, currentNamespace bool, fetch FetchFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			filter, err := generateFilterFromFlags(resolver, currentNamespace)
+			if err != nil {
+				return err
+			}
+
+			values, err := fetch(ctx, api, filter)
+			if err != nil {
+				return err
+			}
+
+			return printValues(values, columns)
+		},
+	}
+
+	return filterFlags(cmd)
+}
+
+// newListCMD creates a command printing all fetched values, the values can not be filtered,
+// the cluster flag is only registered if cluster is true
+func newListCMD(use, short, columns string, cluster bool, fetch ListFunc) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			values, err := fetch(ctx, resolver.API(conn.Port))
+			if err != nil {
+				return err
+			}
+
+			return printValues(values, columns)
+		},
+	}
+
+	return outputFlags(cmd, cluster)
+}
+
+func printValues(values interface{}, columns string) error {
+	if output == "json" || output == "yaml" {
+		prn, err := klo.PrinterFromFlag(output, nil)
+		if err != nil {
+			return err
+		}
+
+		return prn.Fprint(os.Stdout, values)
+	}
+
+	if list, ok := values.([]string); ok {
+		if len(list) == 0 {
+			fmt.Println("No values found")
+			return nil
+		}
+
+		rows := make([]value, 0, len(list))
+		for _, name := range list {
+			rows = append(rows, value{Name: name})
+		}
+
+		values = rows
+	} else if list, ok := values.([]policyreporter.Resource); ok && len(list) == 0 {
+		fmt.Println("No values found")
+		return nil
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{DefaultColumnSpec: columns})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(os.Stdout, values)
+}
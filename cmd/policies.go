@@ -0,0 +1,19 @@
+package cmd
+
+import (
+	"github.com/kyverno/policy-reporter-cli/cmd/policies"
+	"github.com/spf13/cobra"
+)
+
+func newPoliciesCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "policies",
+		Aliases: []string{"pol"},
+		Short:   "List and inspect the policies of namespace and cluster scoped PolicyReportResults",
+	}
+
+	cmd.AddCommand(policies.NewListCMD())
+	cmd.AddCommand(policies.NewDescribeCMD())
+
+	return cmd
+}
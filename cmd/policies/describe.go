@@ -0,0 +1,60 @@
+package policies
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+)
+
+func NewDescribeCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <policy>",
+		Short: "Show the rules and resources affected by a policy",
+		Args:  cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return completion.FromAPI(false, completion.Policies)(cmd, args, toComplete)
+		},
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			results, err := fetchResults(ctx, resolver, api, policyreporter.Filter{Policies: []string{args[0]}})
+			if err != nil {
+				return err
+			}
+
+			details, err := summary.DescribePolicy(results.Items, args[0])
+			if err == summary.ErrPolicyNotFound {
+				fmt.Printf("No results found for policy %s\n", args[0])
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			return render.PolicyDetails(os.Stdout, details, output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json")
+
+	return filterFlags(cmd)
+}
@@ -0,0 +1,66 @@
+package policies
+
+import (
+	"context"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/spf13/cobra"
+)
+
+var (
+	clusterScope  bool
+	allNamespaces bool
+
+	namespaces []string
+	sources    []string
+	categories []string
+	severities []string
+	kinds      []string
+	output     string
+)
+
+// filterFlags registers the flags to filter the PolicyReportResults of the policies
+func filterFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().BoolVar(&clusterScope, "cluster", false, "Use cluster scoped PolicyReportResults")
+	cmd.Flags().StringArrayVarP(&namespaces, "namespace", "n", []string{}, "If present, the namespace scope for this CLI request, repeat the flag for multiple namespaces")
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "If present, use results across all namespaces.")
+	cmd.Flags().StringArrayVarP(&sources, "source", "s", []string{}, "Filter PolicyReportResults by source")
+	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", []string{}, "Filter PolicyReportResults by kinds (only fullqualified singular kind names are supported)")
+	cmd.Flags().StringArrayVar(&categories, "category", []string{}, "Filter PolicyReportResults by category")
+	cmd.Flags().StringArrayVar(&severities, "severity", []string{}, "Filter PolicyReportResults by severity")
+
+	completion.RegisterFlags(cmd, false)
+
+	return cmd
+}
+
+// fetchResults loads the results of the configured scope with their sources
+func fetchResults(ctx context.Context, resolver *config.Resolver, api policyreporter.API, filter policyreporter.Filter) (policyreporter.ResultList, error) {
+	filter.Sources = sources
+	filter.Categories = categories
+	filter.Severities = severities
+	filter.Kinds = kinds
+
+	fetch, listSources := api.Results, api.Sources
+	if clusterScope {
+		fetch, listSources = api.ClusterResults, api.ClusterSources
+	} else if len(namespaces) > 0 {
+		filter.Namespaces = namespaces
+	} else if !allNamespaces {
+		ns, err := resolver.CurrentNamespace()
+		if err != nil {
+			return policyreporter.ResultList{}, err
+		}
+
+		filter.Namespaces = []string{ns}
+	}
+
+	available, err := listSources(ctx)
+	if err != nil {
+		return policyreporter.ResultList{}, err
+	}
+
+	return policyreporter.ResultsWithSource(ctx, fetch, filter, available)
+}
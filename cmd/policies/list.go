@@ -0,0 +1,42 @@
+package policies
+
+import (
+	"context"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+)
+
+func NewListCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List policies with their result counts, categories, severities and sources",
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			results, err := fetchResults(ctx, resolver, api, policyreporter.Filter{})
+			if err != nil {
+				return err
+			}
+
+			return render.Policies(os.Stdout, summary.Policies(results.Items), output)
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|wide|go-template|jsonpath")
+
+	return filterFlags(cmd)
+}
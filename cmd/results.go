@@ -15,6 +15,9 @@ func newResultsCMD() *cobra.Command {
 
 	cmd.AddCommand(results.NewListCMD())
 	cmd.AddCommand(results.NewSearchCMD())
+	cmd.AddCommand(results.NewSummaryCMD())
+	cmd.AddCommand(results.NewScoreCMD())
+	cmd.AddCommand(results.NewTopCMD())
 
 	return cmd
 }
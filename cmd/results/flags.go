@@ -1,31 +1,84 @@
 package results
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/kyverno/policy-reporter-cli/pkg/clientfilter"
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/k8s"
+	"github.com/kyverno/policy-reporter-cli/pkg/remediation"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/spf13/cobra"
+)
 
 var (
-	allNamespaces bool
+	allNamespaces     bool
+	namespaceSelector string
 
-	namespace  string
-	source     string
+	namespaces []string
+	sources    []string
 	output     string
 	groupBy    string
+	sortBy     string
 	results    []string
 	categories []string
 	kinds      []string
 	policies   []string
+	severities []string
+	resources  []string
+
+	clientFilter     clientfilter.Options
+	resourceSelector k8s.ResourceSelector
+	ownerRollup      bool
+	showProperties   bool
+	propertyColumns  []string
+
+	remediations *remediation.Catalogue
+
+	columns    string
+	template   string
+	columnView render.Columns
+
+	markdownDetails bool
+	markdownMaxSize int
 )
 
 func sharedFlags(cmd *cobra.Command) *cobra.Command {
-	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "If present, the namespace scope for this CLI request")
-	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "If present, search results across all namespaces.")
-	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|wide|go-template|jsonpath")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|wide|markdown|go-template|jsonpath")
+	cmd.Flags().StringVar(&columns, "columns", "", "Custom columns spec (e.g. NAME:{.Name},MESSAGE:{.Message}) or the name of a column preset of the config file")
+	cmd.Flags().StringVar(&template, "template", "", "Name of a go-template of the config file to render the PolicyReportResults")
+	cmd.Flags().BoolVar(&markdownDetails, "markdown-details", false, "Collapse each group into a <details> block in markdown output")
+	cmd.Flags().IntVar(&markdownMaxSize, "markdown-max-size", render.DefaultMarkdownMaxSize, "Maximal size of the markdown output in bytes, larger outputs are truncated. 0 disables the limit")
+	cmd.Flags().StringVar(&sortBy, "sort-by", "", "Sort PolicyReportResults by timestamp (oldest first), use -timestamp for the newest first")
+	cmd.Flags().StringVar(&groupBy, "group-by", "result", "Group PolicyReportResults by result, category, policy, resource, namespace, severity, source, kind, rule, properties.<key> or none, nest groupings with a comma separated list (e.g. namespace,policy)")
+	cmd.Flags().StringVarP(&resourceSelector.Labels, "selector", "l", "", "Selector (label query) to filter on, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().StringVar(&resourceSelector.Fields, "field-selector", "", "Selector (field query) to filter the resources on, supports '=', '==', and '!='.(e.g. --field-selector status.phase=Running)")
+	cmd.Flags().StringVar(&resourceSelector.Annotations, "annotation-selector", "", "Selector (annotation query) to filter the resources on, supports 'key', '!key', '=', '==', and '!='.(e.g. --annotation-selector owner=team-a)")
+	cmd.Flags().BoolVar(&ownerRollup, "owner-rollup", false, "Roll up PolicyReportResults of owned resources like Pods to their top level controller and deduplicate identical findings")
+	cmd.Flags().BoolVar(&showProperties, "show-properties", false, "Add a column for each property of the PolicyReportResults")
+	cmd.Flags().StringArrayVar(&propertyColumns, "property-column", []string{}, "Add a column for the given property key, repeat the flag for multiple properties")
 
-	cmd.Flags().StringVarP(&source, "source", "s", "", "Filter PolicyReportResults by source")
+	cmd.RegisterFlagCompletionFunc("columns", completion.Views(config.ResultsView, false))
+	cmd.RegisterFlagCompletionFunc("template", completion.Views(config.ResultsView, true))
+
+	return filterFlags(cmd)
+}
+
+// filterFlags registers the flags to filter PolicyReportResults
+func filterFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringArrayVarP(&namespaces, "namespace", "n", []string{}, "If present, the namespace scope for this CLI request, repeat the flag for multiple namespaces")
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "If present, search results across all namespaces.")
+	cmd.Flags().StringVar(&namespaceSelector, "namespace-selector", "", "Selector (label query) for the namespaces to search results in (e.g. --namespace-selector team=payments)")
+	cmd.Flags().StringArrayVarP(&sources, "source", "s", []string{}, "Filter PolicyReportResults by source")
 	cmd.Flags().StringArrayVar(&results, "result", []string{}, "Filter PolicyReportResults by result")
 	cmd.Flags().StringArrayVarP(&kinds, "kind", "k", []string{}, "Filter PolicyReportResults by kinds (only fullqualified singular kind names are supported)")
 	cmd.Flags().StringArrayVar(&categories, "category", []string{}, "Filter PolicyReportResults by category")
 	cmd.Flags().StringArrayVar(&policies, "policy", []string{}, "Filter PolicyReportResults by policy name")
-	cmd.Flags().StringVar(&groupBy, "group-by", "result", "Group PolicyReportResults by result, category, resource, none")
+	cmd.Flags().StringArrayVar(&severities, "severity", []string{}, "Filter PolicyReportResults by severity")
+	cmd.Flags().StringArrayVar(&resources, "resource", []string{}, "Filter PolicyReportResults by resource name")
+
+	clientfilter.AddFlags(cmd.Flags(), &clientFilter, true)
+
+	completion.RegisterFlags(cmd, false)
 
 	return cmd
 }
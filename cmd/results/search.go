@@ -8,8 +8,10 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/kyverno/policy-reporter-cli/pkg/cli"
 	"github.com/kyverno/policy-reporter-cli/pkg/config"
 	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
 	"github.com/spf13/cobra"
 	"github.com/ttacon/chalk"
 )
@@ -28,7 +30,8 @@ var (
 		"Namespace": selectNamespaces,
 	}
 
-	ErrNoResult = errors.New("No results")
+	ErrNoResult             = errors.New("No results")
+	ErrNoMatchingNamespaces = errors.New("No namespaces matching the namespace selector")
 )
 
 func NewSearchCMD() *cobra.Command {
@@ -40,6 +43,18 @@ func NewSearchCMD() *cobra.Command {
 
 			resolver := config.NewResolver(config.LoadConfig())
 
+			view, err := render.ResolveColumns(resolver.View(config.ResultsView), output, columns, template)
+			if err != nil {
+				return err
+			}
+
+			columnView = view
+
+			groupings, err := cli.ParseGroupings(groupBy)
+			if err != nil {
+				return err
+			}
+
 			conn, err := resolver.ForwardPolicyReporter(ctx)
 			if err != nil {
 				return nil
@@ -49,6 +64,15 @@ func NewSearchCMD() *cobra.Command {
 			api := resolver.API(conn.Port)
 
 			apiFilter := generateFilterFromFlags("")
+
+			err = applyNamespaceSelector(ctx, resolver, &apiFilter)
+			if err == ErrNoMatchingNamespaces {
+				fmt.Println("No results found")
+				return nil
+			} else if err != nil {
+				return err
+			}
+
 			filters := []string{}
 
 			prompt := &survey.MultiSelect{
@@ -81,14 +105,32 @@ func NewSearchCMD() *cobra.Command {
 				}
 			}
 
-			results, err := api.Results(ctx, apiFilter)
+			results, err := fetchResults(ctx, api, apiFilter, cli.HasGrouping(groupings, cli.SourceGrouping) || clientFilter.RequiresSource())
 			if err != nil {
 				return err
 			}
 
-			buildTable(grouingResults(ctx, results, api, apiFilter))
+			results, err = applyClientFilter(results)
+			if err != nil {
+				return err
+			}
+
+			results, err = applyResourceSelector(ctx, resolver, results)
+			if err != nil {
+				return err
+			}
+
+			results, err = applyOwnerRollup(ctx, resolver, results)
+			if err != nil {
+				return err
+			}
+
+			err = applyRemediations(resolver, results)
+			if err != nil {
+				return err
+			}
 
-			return nil
+			return buildTable(grouingResults(ctx, results, api, apiFilter, groupings))
 		},
 	}
 
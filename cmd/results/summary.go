@@ -0,0 +1,74 @@
+package results
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+)
+
+var (
+	dimension string
+	barWidth  int
+)
+
+func NewSummaryCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Short: "Summarize PolicyReportResults by result and namespace, policy, category, severity, source or kind",
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			ns, err := resolver.CurrentNamespace()
+			if err != nil {
+				return err
+			}
+
+			filter := generateFilterFromFlags(ns)
+
+			err = applyNamespaceSelector(ctx, resolver, &filter)
+			if err == ErrNoMatchingNamespaces {
+				fmt.Println("No results found")
+				return nil
+			} else if err != nil {
+				return err
+			}
+
+			results, err := fetchResults(ctx, api, filter, dimension == summary.SourceDimension || clientFilter.RequiresSource())
+			if err != nil {
+				return err
+			}
+
+			results, err = applyClientFilter(results)
+			if err != nil {
+				return err
+			}
+
+			s, err := summary.Summarize(results.Items, dimension)
+			if err != nil {
+				return err
+			}
+
+			return render.Summary(os.Stdout, s, output, barWidth)
+		},
+	}
+
+	cmd.Flags().StringVar(&dimension, "by", summary.NamespaceDimension, "Count PolicyReportResults by namespace, policy, category, severity, source, kind, resource, rule")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|bar|go-template|jsonpath")
+	cmd.Flags().IntVar(&barWidth, "bar-width", render.DefaultBarWidth, "Width of the longest bar in characters for bar output")
+
+	return filterFlags(cmd)
+}
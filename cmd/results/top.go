@@ -0,0 +1,89 @@
+package results
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/spf13/cobra"
+)
+
+var (
+	topBy    string
+	topLimit int
+)
+
+func NewTopCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "Rank resources, policies, rules or namespaces by their failing PolicyReportResults",
+		RunE: func(command *cobra.Command, args []string) error {
+			ctx := context.Background()
+			c := config.LoadConfig()
+			resolver := config.NewResolver(c)
+
+			var weights summary.Weights
+			if weighted || len(weightFlags) > 0 {
+				w, err := summary.ResolveWeights(c.Score.Weights, weightFlags)
+				if err != nil {
+					return err
+				}
+				weights = w
+			}
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			ns, err := resolver.CurrentNamespace()
+			if err != nil {
+				return err
+			}
+
+			filter := generateFilterFromFlags(ns)
+
+			err = applyNamespaceSelector(ctx, resolver, &filter)
+			if err == ErrNoMatchingNamespaces {
+				fmt.Println("No results found")
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if len(filter.Status) == 0 {
+				filter.Status = summary.OffenderResults
+			}
+
+			results, err := fetchResults(ctx, api, filter, topBy == summary.SourceDimension || clientFilter.RequiresSource())
+			if err != nil {
+				return err
+			}
+
+			results, err = applyClientFilter(results)
+			if err != nil {
+				return err
+			}
+
+			offenders, err := summary.TopOffenders(results.Items, topBy, weights, topLimit)
+			if err != nil {
+				return err
+			}
+
+			return render.TopOffenders(os.Stdout, offenders, topBy, output)
+		},
+	}
+
+	cmd.Flags().StringVar(&topBy, "by", summary.ResourceDimension, "Rank by resource, policy, rule, namespace, source")
+	cmd.Flags().IntVar(&topLimit, "limit", 10, "Number of ranked entries, 0 shows all")
+	cmd.Flags().BoolVar(&weighted, "weighted", false, "Weight each result by its severity (default weights: high=3,medium=2,low=1)")
+	cmd.Flags().StringToStringVar(&weightFlags, "weight", map[string]string{}, "Severity weights, implies --weighted (e.g. --weight high=5,medium=2)")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: yaml|json|go-template|jsonpath")
+
+	return filterFlags(cmd)
+}
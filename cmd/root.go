@@ -17,6 +17,13 @@ func NewCLI(version string) *cobra.Command {
 	rootCmd.AddCommand(newTargetsCMD())
 	rootCmd.AddCommand(newResultsCMD())
 	rootCmd.AddCommand(newClusterResultsCMD())
+	rootCmd.AddCommand(newDescribeCMD())
+	rootCmd.AddCommand(newExceptionsCMD())
+	rootCmd.AddCommand(newFixCMD())
+	rootCmd.AddCommand(newGetCMD())
+	rootCmd.AddCommand(newPoliciesCMD())
+	rootCmd.AddCommand(newTUICMD())
+	rootCmd.AddCommand(newVulnsCMD())
 	rootCmd.AddCommand(newVersionCMD(version))
 
 	flag.Parse()
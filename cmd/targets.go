@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
 	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
 	"github.com/spf13/cobra"
 	"github.com/thediveo/klo"
 )
 
-var output string
+var (
+	output          string
+	targetsColumns  string
+	targetsTemplate string
+)
 
 func newTargetsCMD() *cobra.Command {
 	cmd := &cobra.Command{
@@ -22,6 +28,11 @@ func newTargetsCMD() *cobra.Command {
 
 			resolver := config.NewResolver(config.LoadConfig())
 
+			view, err := render.ResolveColumns(resolver.View(config.TargetsView), output, targetsColumns, targetsTemplate)
+			if err != nil {
+				return err
+			}
+
 			conn, err := resolver.ForwardPolicyReporter(ctx)
 			if err != nil {
 				return err
@@ -39,11 +50,12 @@ func newTargetsCMD() *cobra.Command {
 				return nil
 			}
 
-			prn, err := klo.PrinterFromFlag(output, &klo.Specs{
-				DefaultColumnSpec: "TARGET:{.Name},MINIMUM PRIORITY:{.MinimumPriority},SKIP EXISTING ON STARTUP:{.SkipExistingOnStartup},SOURCE:{.Source}",
-			})
+			prn, err := klo.PrinterFromFlag(view.Output, view.Specs(
+				"TARGET:{.Name},MINIMUM PRIORITY:{.MinimumPriority},SKIP EXISTING ON STARTUP:{.SkipExistingOnStartup},SOURCE:{.Source}",
+				"",
+			))
 			if err != nil {
-				fmt.Println(err)
+				return err
 			}
 
 			return prn.Fprint(os.Stdout, targets)
@@ -51,6 +63,11 @@ func newTargetsCMD() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&output, "output", "o", "", "Output Format")
+	cmd.Flags().StringVar(&targetsColumns, "columns", "", "Custom columns spec (e.g. TARGET:{.Name},SOURCE:{.Source}) or the name of a column preset of the config file")
+	cmd.Flags().StringVar(&targetsTemplate, "template", "", "Name of a go-template of the config file to render the targets")
+
+	cmd.RegisterFlagCompletionFunc("columns", completion.Views(config.TargetsView, false))
+	cmd.RegisterFlagCompletionFunc("template", completion.Views(config.TargetsView, true))
 
 	return cmd
 }
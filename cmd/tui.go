@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/tui"
+	"github.com/spf13/cobra"
+)
+
+var clusterScope bool
+
+func newTUICMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tui",
+		Short: "Browse (Cluster)PolicyReportResults in an interactive full screen UI",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+
+			resolver := config.NewResolver(config.LoadConfig())
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			load := func(ctx context.Context, cluster bool) (policyreporter.ResultList, error) {
+				if cluster {
+					return api.ClusterResults(ctx, policyreporter.Filter{})
+				}
+
+				return api.Results(ctx, policyreporter.Filter{})
+			}
+
+			return tui.NewBrowser(ctx, load, clusterScope).Run()
+		},
+	}
+
+	cmd.Flags().BoolVar(&clusterScope, "cluster", false, "Start with cluster scoped results")
+
+	return cmd
+}
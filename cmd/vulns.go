@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/clientfilter"
+	"github.com/kyverno/policy-reporter-cli/pkg/completion"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/render"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/kyverno/policy-reporter-cli/pkg/vulns"
+	"github.com/spf13/cobra"
+)
+
+var (
+	vulnsNamespaces    []string
+	vulnsAllNamespaces bool
+	vulnsSources       []string
+	vulnsSeverities    []string
+	vulnsKinds         []string
+	vulnsResources     []string
+	vulnsImages        []string
+	vulnsFixable       bool
+	vulnsBy            string
+	vulnsSortBy        string
+	vulnsOutput        string
+)
+
+func newVulnsCMD() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vulns",
+		Aliases: []string{"vulnerabilities"},
+		Short:   "Aggregate the vulnerabilities of Trivy and other scanner sources per image or CVE",
+		Long: `Aggregate the vulnerabilities of Trivy and other scanner sources per image or CVE.
+
+The CVE ID, package, installed and fixed version, score and image are parsed from the result properties.
+Scanner sources are detected by the source patterns configured under vulnerabilities.sources,
+use --source to select the sources explicitly.`,
+		Example: `  pr vulns -A
+  pr vulns -n default --by cve --sort-by fixable
+  pr vulns -A --by none --image 'docker.io/*' --fixable`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			c := config.LoadConfig()
+			resolver := config.NewResolver(c)
+
+			imageMatchers, err := clientfilter.NewMatchers(vulnsImages)
+			if err != nil {
+				return err
+			}
+
+			conn, err := resolver.ForwardPolicyReporter(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			api := resolver.API(conn.Port)
+
+			filter := policyreporter.Filter{
+				Severities: vulnsSeverities,
+				Kinds:      vulnsKinds,
+				Resources:  vulnsResources,
+				Status:     summary.OffenderResults,
+			}
+
+			if len(vulnsNamespaces) > 0 {
+				filter.Namespaces = vulnsNamespaces
+			} else if !vulnsAllNamespaces {
+				ns, err := resolver.CurrentNamespace()
+				if err != nil {
+					return err
+				}
+
+				filter.Namespaces = []string{ns}
+			}
+
+			sources := vulnsSources
+			if len(sources) == 0 {
+				sources, err = scannerSources(ctx, api, c.Vulnerabilities.Sources)
+				if err != nil {
+					return err
+				}
+			}
+
+			if len(sources) == 0 {
+				fmt.Println("No scanner sources found, use --source to select the sources")
+				return nil
+			}
+
+			results, err := policyreporter.ResultsWithSource(ctx, api.Results, filter, sources)
+			if err != nil {
+				return err
+			}
+
+			list := make([]vulns.Vulnerability, 0, len(results.Items))
+			for _, v := range vulns.ParseAll(results.Items) {
+				if vulnsFixable && !v.Fixable {
+					continue
+				}
+
+				if len(imageMatchers) > 0 && !clientfilter.MatchAny(imageMatchers, v.Image) {
+					continue
+				}
+
+				list = append(list, v)
+			}
+
+			switch vulnsBy {
+			case vulns.ImageView:
+				images := vulns.ByImage(list)
+				if err := vulns.SortImages(images, vulnsSortBy); err != nil {
+					return err
+				}
+
+				return render.VulnerableImages(os.Stdout, images, vulnsOutput)
+			case vulns.CVEView:
+				cves := vulns.ByCVE(list)
+				if err := vulns.SortCVEs(cves, vulnsSortBy); err != nil {
+					return err
+				}
+
+				return render.CVEs(os.Stdout, cves, vulnsOutput)
+			case vulns.NoneView:
+				if err := vulns.SortVulnerabilities(list, vulnsSortBy); err != nil {
+					return err
+				}
+
+				return render.Vulnerabilities(os.Stdout, list, vulnsOutput)
+			}
+
+			return fmt.Errorf("unsupported view %q, expected one of: %s, %s, %s", vulnsBy, vulns.ImageView, vulns.CVEView, vulns.NoneView)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&vulnsNamespaces, "namespace", "n", []string{}, "If present, the namespace scope for this CLI request, repeat the flag for multiple namespaces")
+	cmd.Flags().BoolVarP(&vulnsAllNamespaces, "all-namespaces", "A", false, "If present, use results across all namespaces.")
+	cmd.Flags().StringArrayVarP(&vulnsSources, "source", "s", []string{}, "Scanner sources of the vulnerabilities, defaults to all sources matching the configured vulnerabilities.sources patterns")
+	cmd.Flags().StringArrayVar(&vulnsSeverities, "severity", []string{}, "Filter vulnerabilities by severity")
+	cmd.Flags().StringArrayVarP(&vulnsKinds, "kind", "k", []string{}, "Filter vulnerabilities by kinds (only fullqualified singular kind names are supported)")
+	cmd.Flags().StringArrayVar(&vulnsResources, "resource", []string{}, "Filter vulnerabilities by resource name")
+	cmd.Flags().StringArrayVar(&vulnsImages, "image", []string{}, "Filter vulnerabilities by images matching the pattern (e.g. 'docker.io/*')")
+	cmd.Flags().BoolVar(&vulnsFixable, "fixable", false, "Only show vulnerabilities with a fixed version")
+	cmd.Flags().StringVar(&vulnsBy, "by", vulns.ImageView, "Aggregate vulnerabilities by image, cve or none to list each vulnerability per resource")
+	cmd.Flags().StringVar(&vulnsSortBy, "sort-by", vulns.SeveritySorting, "Sort by severity, fixable, score or count, count sorts images by the number of vulnerabilities and CVEs by the number of resources and is not supported by --by none")
+	cmd.Flags().StringVarP(&vulnsOutput, "output", "o", "", "Output format. One of: yaml|json|wide|go-template|jsonpath")
+
+	cmd.RegisterFlagCompletionFunc("namespace", completion.FromAPI(false, completion.Namespaces))
+	cmd.RegisterFlagCompletionFunc("source", completion.FromAPI(false, completion.Sources))
+	cmd.RegisterFlagCompletionFunc("kind", completion.FromAPI(false, completion.Kinds))
+	cmd.RegisterFlagCompletionFunc("resource", completion.FromAPI(false, completion.Resources))
+	cmd.RegisterFlagCompletionFunc("by", completion.Static(vulns.ImageView, vulns.CVEView, vulns.NoneView))
+	cmd.RegisterFlagCompletionFunc("sort-by", completion.Static(vulns.SeveritySorting, vulns.FixableSorting, vulns.ScoreSorting, vulns.CountSorting))
+	cmd.RegisterFlagCompletionFunc("output", completion.Static("json", "yaml", "wide"))
+
+	return cmd
+}
+
+// scannerSources returns the available sources matching the configured patterns
+func scannerSources(ctx context.Context, api policyreporter.API, patterns []string) ([]string, error) {
+	matchers, err := clientfilter.NewMatchers(patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	available, err := api.Sources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]string, 0, len(available))
+	for _, source := range available {
+		if clientfilter.MatchAny(matchers, source) {
+			sources = append(sources, source)
+		}
+	}
+
+	return sources, nil
+}
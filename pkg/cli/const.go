@@ -3,8 +3,29 @@ package cli
 type Grouping = string
 
 const (
-	CategoryGrouping Grouping = "category"
-	ResourceGrouping Grouping = "resource"
-	PolicyGrouping   Grouping = "policy"
-	NoneGroup        Grouping = "none"
+	ResultGrouping    Grouping = "result"
+	CategoryGrouping  Grouping = "category"
+	ResourceGrouping  Grouping = "resource"
+	PolicyGrouping    Grouping = "policy"
+	NamespaceGrouping Grouping = "namespace"
+	SeverityGrouping  Grouping = "severity"
+	SourceGrouping    Grouping = "source"
+	KindGrouping      Grouping = "kind"
+	RuleGrouping      Grouping = "rule"
+	NoneGroup         Grouping = "none"
+
+	// PropertyGroupingPrefix groups by the value of the property key following the prefix (e.g. properties.resultID)
+	PropertyGroupingPrefix Grouping = "properties."
+)
+
+type Output = string
+
+const (
+	MarkdownOutput Output = "markdown"
+)
+
+type Sorting = string
+
+const (
+	TimestampSorting Sorting = "timestamp"
 )
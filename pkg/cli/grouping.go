@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Groupings are the supported values of --group-by, besides PropertyGroupingPrefix
+var Groupings = []Grouping{
+	ResultGrouping,
+	CategoryGrouping,
+	PolicyGrouping,
+	ResourceGrouping,
+	NamespaceGrouping,
+	SeverityGrouping,
+	SourceGrouping,
+	KindGrouping,
+	RuleGrouping,
+	NoneGroup,
+}
+
+// ParseGroupings splits the comma separated list of groupings and validates each of them,
+// an empty grouping falls back to ResultGrouping
+func ParseGroupings(value string) ([]Grouping, error) {
+	groupings := make([]Grouping, 0)
+
+	for _, grouping := range strings.Split(value, ",") {
+		grouping = strings.TrimSpace(grouping)
+		if grouping == "" {
+			grouping = ResultGrouping
+		}
+
+		if !validGrouping(grouping) {
+			return nil, fmt.Errorf("unsupported grouping %q, expected one of: %s, %s<key>", grouping, strings.Join(Groupings, ", "), PropertyGroupingPrefix)
+		}
+
+		groupings = append(groupings, grouping)
+	}
+
+	return groupings, nil
+}
+
+// HasGrouping reports if the grouping is part of the groupings
+func HasGrouping(groupings []Grouping, grouping Grouping) bool {
+	for _, item := range groupings {
+		if item == grouping {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validGrouping(grouping Grouping) bool {
+	if strings.HasPrefix(grouping, PropertyGroupingPrefix) {
+		return len(grouping) > len(PropertyGroupingPrefix)
+	}
+
+	return HasGrouping(Groupings, grouping)
+}
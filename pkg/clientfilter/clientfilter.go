@@ -0,0 +1,38 @@
+package clientfilter
+
+import (
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// Predicate returns true if the result should be kept
+type Predicate = func(policyreporter.PolicyReportResult) bool
+
+// Chain of Predicates applied to results after they are fetched from the API, a result is kept if all Predicates match
+type Chain []Predicate
+
+// Apply returns all results matching the Chain
+func (c Chain) Apply(list policyreporter.ResultList) policyreporter.ResultList {
+	if len(c) == 0 {
+		return list
+	}
+
+	filtered := make([]policyreporter.PolicyReportResult, 0, len(list.Items))
+	for _, result := range list.Items {
+		if c.Match(result) {
+			filtered = append(filtered, result)
+		}
+	}
+
+	return policyreporter.ResultList{Items: filtered, Count: len(filtered)}
+}
+
+// Match is true if the result matches all Predicates
+func (c Chain) Match(result policyreporter.PolicyReportResult) bool {
+	for _, predicate := range c {
+		if !predicate(result) {
+			return false
+		}
+	}
+
+	return true
+}
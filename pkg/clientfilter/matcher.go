@@ -0,0 +1,74 @@
+package clientfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RegexPrefix marks a pattern as regular expression, all other patterns are globs
+const RegexPrefix = "re:"
+
+// Matcher matches values against a glob or regex pattern
+type Matcher interface {
+	Match(value string) bool
+}
+
+type regexMatcher struct {
+	regex *regexp.Regexp
+}
+
+func (m regexMatcher) Match(value string) bool {
+	return m.regex.MatchString(value)
+}
+
+// NewMatcher creates a Matcher for the given pattern.
+// Patterns prefixed with "re:" are unanchored regular expressions,
+// all others are globs matching the complete value with "*" for any sequence of characters and "?" for a single character.
+func NewMatcher(pattern string) (Matcher, error) {
+	if strings.HasPrefix(pattern, RegexPrefix) {
+		regex, err := regexp.Compile(strings.TrimPrefix(pattern, RegexPrefix))
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex pattern %q: %w", pattern, err)
+		}
+
+		return regexMatcher{regex}, nil
+	}
+
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.ReplaceAll(expression, `\*`, ".*")
+	expression = strings.ReplaceAll(expression, `\?`, ".")
+
+	regex, err := regexp.Compile("^" + expression + "$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob pattern %q: %w", pattern, err)
+	}
+
+	return regexMatcher{regex}, nil
+}
+
+// NewMatchers creates a Matcher for each pattern
+func NewMatchers(patterns []string) ([]Matcher, error) {
+	matchers := make([]Matcher, 0, len(patterns))
+	for _, pattern := range patterns {
+		matcher, err := NewMatcher(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		matchers = append(matchers, matcher)
+	}
+
+	return matchers, nil
+}
+
+// MatchAny is true if any Matcher matches the value
+func MatchAny(matchers []Matcher, value string) bool {
+	for _, matcher := range matchers {
+		if matcher.Match(value) {
+			return true
+		}
+	}
+
+	return false
+}
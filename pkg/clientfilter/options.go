@@ -0,0 +1,149 @@
+package clientfilter
+
+import (
+	"time"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/query"
+	"github.com/spf13/pflag"
+)
+
+// Options for filters which are not supported by the REST API
+type Options struct {
+	ExcludeNamespaces []string
+	ExcludePolicies   []string
+	ExcludeRules      []string
+	ExcludeKinds      []string
+	ExcludeCategories []string
+	ExcludeResources  []string
+	ExcludeSeverities []string
+
+	NamespacePatterns []string
+	PolicyPatterns    []string
+	ResourcePatterns  []string
+	MessagePatterns   []string
+
+	Properties []string
+
+	Since     string
+	Until     string
+	OlderThan string
+
+	Query string
+}
+
+// AddFlags registers the flags for all Options, namespace flags are skipped for cluster scoped results
+func AddFlags(flags *pflag.FlagSet, o *Options, namespaced bool) {
+	if namespaced {
+		flags.StringArrayVar(&o.ExcludeNamespaces, "exclude-namespace", []string{}, "Exclude PolicyReportResults of namespaces matching the pattern")
+		flags.StringArrayVar(&o.NamespacePatterns, "namespace-pattern", []string{}, "Filter PolicyReportResults by namespaces matching the pattern (e.g. 'team-*')")
+	}
+
+	flags.StringArrayVar(&o.ExcludePolicies, "exclude-policy", []string{}, "Exclude PolicyReportResults of policies matching the pattern")
+	flags.StringArrayVar(&o.ExcludeRules, "exclude-rule", []string{}, "Exclude PolicyReportResults of rules matching the pattern")
+	flags.StringArrayVar(&o.ExcludeKinds, "exclude-kind", []string{}, "Exclude PolicyReportResults of kinds matching the pattern")
+	flags.StringArrayVar(&o.ExcludeCategories, "exclude-category", []string{}, "Exclude PolicyReportResults of categories matching the pattern")
+	flags.StringArrayVar(&o.ExcludeResources, "exclude-resource", []string{}, "Exclude PolicyReportResults of resource names matching the pattern")
+	flags.StringArrayVar(&o.ExcludeSeverities, "exclude-severity", []string{}, "Exclude PolicyReportResults with severities matching the pattern")
+
+	flags.StringArrayVar(&o.PolicyPatterns, "policy-pattern", []string{}, "Filter PolicyReportResults by policies matching the pattern")
+	flags.StringArrayVar(&o.ResourcePatterns, "resource-pattern", []string{}, "Filter PolicyReportResults by resource names matching the pattern")
+	flags.StringArrayVar(&o.MessagePatterns, "message-pattern", []string{}, "Filter PolicyReportResults by messages matching the pattern (e.g. 're:(?i)privileged')")
+
+	flags.StringArrayVar(&o.Properties, "property", []string{}, "Filter PolicyReportResults by properties, supports 'key=pattern', 'key!=pattern', 'key' and '!key' (e.g. --property resultID=CVE-2023-*)")
+
+	flags.StringVar(&o.Since, "since", "", "Only PolicyReportResults created since the given duration (e.g. 24h, 7d) or RFC3339 time")
+	flags.StringVar(&o.Until, "until", "", "Only PolicyReportResults created until the given duration (e.g. 24h, 7d) or RFC3339 time")
+	flags.StringVar(&o.OlderThan, "older-than", "", "Only PolicyReportResults older than the given duration (e.g. 30d)")
+
+	flags.StringVarP(&o.Query, "query", "q", "", "Filter PolicyReportResults by a CEL expression (e.g. 'status == \"fail\" && properties.image.startsWith(\"docker.io\")')")
+}
+
+// RequiresSource reports whether the query uses the source of the results,
+// which is only set if the results are fetched per source
+func (o Options) RequiresSource() bool {
+	return o.Query != "" && query.References(o.Query, "source")
+}
+
+// Chain creates the Predicates for all configured Options
+func (o Options) Chain() (Chain, error) {
+	chain, err := o.timeChain(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	excludes := []struct {
+		patterns []string
+		value    func(policyreporter.PolicyReportResult) string
+	}{
+		{o.ExcludeNamespaces, func(r policyreporter.PolicyReportResult) string { return r.Namespace }},
+		{o.ExcludePolicies, func(r policyreporter.PolicyReportResult) string { return r.Policy }},
+		{o.ExcludeRules, func(r policyreporter.PolicyReportResult) string { return r.Rule }},
+		{o.ExcludeKinds, func(r policyreporter.PolicyReportResult) string { return r.Kind }},
+		{o.ExcludeCategories, func(r policyreporter.PolicyReportResult) string { return r.Category }},
+		{o.ExcludeResources, func(r policyreporter.PolicyReportResult) string { return r.Name }},
+		{o.ExcludeSeverities, func(r policyreporter.PolicyReportResult) string { return r.Severity }},
+	}
+
+	for _, exclude := range excludes {
+		if len(exclude.patterns) == 0 {
+			continue
+		}
+
+		matchers, err := NewMatchers(exclude.patterns)
+		if err != nil {
+			return nil, err
+		}
+
+		value := exclude.value
+		chain = append(chain, func(r policyreporter.PolicyReportResult) bool {
+			return !MatchAny(matchers, value(r))
+		})
+	}
+
+	includes := []struct {
+		patterns []string
+		value    func(policyreporter.PolicyReportResult) string
+	}{
+		{o.NamespacePatterns, func(r policyreporter.PolicyReportResult) string { return r.Namespace }},
+		{o.PolicyPatterns, func(r policyreporter.PolicyReportResult) string { return r.Policy }},
+		{o.ResourcePatterns, func(r policyreporter.PolicyReportResult) string { return r.Name }},
+		{o.MessagePatterns, func(r policyreporter.PolicyReportResult) string { return r.Message }},
+	}
+
+	for _, include := range includes {
+		if len(include.patterns) == 0 {
+			continue
+		}
+
+		matchers, err := NewMatchers(include.patterns)
+		if err != nil {
+			return nil, err
+		}
+
+		value := include.value
+		chain = append(chain, func(r policyreporter.PolicyReportResult) bool {
+			return MatchAny(matchers, value(r))
+		})
+	}
+
+	for _, value := range o.Properties {
+		filter, err := parsePropertyFilter(value)
+		if err != nil {
+			return nil, err
+		}
+
+		chain = append(chain, filter.Match)
+	}
+
+	if o.Query != "" {
+		predicate, err := query.Compile(o.Query)
+		if err != nil {
+			return nil, err
+		}
+
+		chain = append(chain, predicate)
+	}
+
+	return chain, nil
+}
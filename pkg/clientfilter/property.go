@@ -0,0 +1,59 @@
+package clientfilter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// propertyFilter matches the value of a single result property, negated filters also match results without the property
+type propertyFilter struct {
+	key     string
+	negate  bool
+	matcher Matcher
+}
+
+func (f propertyFilter) Match(result policyreporter.PolicyReportResult) bool {
+	value, ok := result.Properties[f.key]
+	if f.matcher == nil {
+		return ok != f.negate
+	}
+
+	if !ok {
+		return f.negate
+	}
+
+	return f.matcher.Match(value) != f.negate
+}
+
+// parsePropertyFilter parses 'key=pattern', 'key!=pattern', 'key' and '!key' filters
+func parsePropertyFilter(value string) (propertyFilter, error) {
+	key, pattern, negate, hasPattern := value, "", false, false
+
+	if k, p, ok := strings.Cut(value, "!="); ok {
+		key, pattern, negate, hasPattern = k, p, true, true
+	} else if k, p, ok := strings.Cut(value, "="); ok {
+		key, pattern, hasPattern = k, p, true
+	} else if strings.HasPrefix(value, "!") {
+		key, negate = strings.TrimPrefix(value, "!"), true
+	}
+
+	filter := propertyFilter{key: strings.TrimPrefix(strings.TrimSpace(key), "properties."), negate: negate}
+	if filter.key == "" {
+		return filter, fmt.Errorf("invalid property filter %q, expected 'key=value', 'key!=value', 'key' or '!key'", value)
+	}
+
+	if !hasPattern {
+		return filter, nil
+	}
+
+	matcher, err := NewMatcher(pattern)
+	if err != nil {
+		return filter, err
+	}
+
+	filter.matcher = matcher
+
+	return filter, nil
+}
@@ -0,0 +1,79 @@
+package clientfilter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// ParseTime parses an RFC3339 time or a duration relative to now, e.g. "24h" or "30d"
+func ParseTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	duration, err := ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected a duration like '24h', '30d' or a RFC3339 time", value)
+	}
+
+	return now.Add(-duration), nil
+}
+
+// ParseDuration extends time.ParseDuration with the "d" unit for days
+func ParseDuration(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(value, "d"), 64)
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(days * float64(24*time.Hour)), nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// timeChain creates the Predicates for the time based Options, results without timestamp never match
+func (o Options) timeChain(now time.Time) (Chain, error) {
+	chain := Chain{}
+
+	bounds := []struct {
+		value  string
+		before bool
+	}{
+		{o.Since, false},
+		{o.Until, true},
+		{o.OlderThan, true},
+	}
+
+	for _, bound := range bounds {
+		if bound.value == "" {
+			continue
+		}
+
+		t, err := ParseTime(bound.value, now)
+		if err != nil {
+			return nil, err
+		}
+
+		limit := t.Unix()
+		before := bound.before
+
+		chain = append(chain, func(r policyreporter.PolicyReportResult) bool {
+			if r.Timestamp == 0 {
+				return false
+			}
+			if before {
+				return int64(r.Timestamp) <= limit
+			}
+
+			return int64(r.Timestamp) >= limit
+		})
+	}
+
+	return chain, nil
+}
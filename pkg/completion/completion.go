@@ -0,0 +1,172 @@
+package completion
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/cli"
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/spf13/cobra"
+)
+
+// CompletionFunc is the signature of cobra flag and argument completions
+type CompletionFunc = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective)
+
+// ValuesFunc loads completion values from the API, cluster is true for cluster scoped results
+type ValuesFunc = func(ctx context.Context, api policyreporter.API, cluster bool) ([]string, error)
+
+// Groupings are the supported values of --group-by
+var Groupings = cli.Groupings
+
+// Static completes the given values
+func Static(values ...string) CompletionFunc {
+	return func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// List completes comma separated lists of the given values
+func List(values ...string) CompletionFunc {
+	return func(_ *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		prefix := ""
+		if index := strings.LastIndex(toComplete, ","); index >= 0 {
+			prefix = toComplete[:index+1]
+		}
+
+		completions := make([]string, 0, len(values))
+		for _, value := range values {
+			completions = append(completions, prefix+value)
+		}
+
+		return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+// FromAPI completes the values loaded from the Policy Reporter API, there is no cache so each completion opens a new port forward.
+// The scope is cluster if cluster is true or the command has a --cluster flag which is set.
+func FromAPI(cluster bool, fetch ValuesFunc) CompletionFunc {
+	return func(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		scope := cluster
+		if value, err := cmd.Flags().GetBool("cluster"); err == nil && value {
+			scope = true
+		}
+
+		ctx := context.Background()
+		resolver := config.NewResolver(config.LoadConfig())
+
+		conn, err := resolver.ForwardPolicyReporter(ctx)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		defer conn.Close()
+
+		values, err := fetch(ctx, resolver.API(conn.Port), scope)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// Categories loads all categories
+func Categories(ctx context.Context, api policyreporter.API, _ bool) ([]string, error) {
+	return api.Categories(ctx)
+}
+
+// Sources loads all sources of the scope
+func Sources(ctx context.Context, api policyreporter.API, cluster bool) ([]string, error) {
+	if cluster {
+		return api.ClusterSources(ctx)
+	}
+
+	return api.Sources(ctx)
+}
+
+// Policies loads all policies of the scope
+func Policies(ctx context.Context, api policyreporter.API, cluster bool) ([]string, error) {
+	if cluster {
+		return api.ClusterPolicies(ctx, policyreporter.Filter{})
+	}
+
+	return api.Policies(ctx, policyreporter.Filter{})
+}
+
+// Kinds loads all kinds of the scope
+func Kinds(ctx context.Context, api policyreporter.API, cluster bool) ([]string, error) {
+	if cluster {
+		return api.ClusterKinds(ctx, policyreporter.Filter{})
+	}
+
+	return api.Kinds(ctx, policyreporter.Filter{})
+}
+
+// Namespaces loads all namespaces with namespace scoped results
+func Namespaces(ctx context.Context, api policyreporter.API, _ bool) ([]string, error) {
+	return api.Namespaces(ctx, policyreporter.Filter{})
+}
+
+// Resources loads all resources of the scope as lower case <kind>/<name>
+func Resources(ctx context.Context, api policyreporter.API, cluster bool) ([]string, error) {
+	fetch := api.Resources
+	if cluster {
+		fetch = api.ClusterResources
+	}
+
+	resources, err := fetch(ctx, policyreporter.Filter{})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		values = append(values, strings.ToLower(resource.Kind)+"/"+resource.Name)
+	}
+
+	return values, nil
+}
+
+// RegisterFlags registers the completions of all known filter flags the command has
+func RegisterFlags(cmd *cobra.Command, cluster bool) {
+	completions := map[string]CompletionFunc{
+		"category":  FromAPI(cluster, Categories),
+		"policy":    FromAPI(cluster, Policies),
+		"kind":      FromAPI(cluster, Kinds),
+		"source":    FromAPI(cluster, Sources),
+		"namespace": FromAPI(cluster, Namespaces),
+		"result":    Static(policyreporter.AllResults...),
+		"severity":  Static(policyreporter.High, policyreporter.Medium, policyreporter.Low),
+		"group-by":  List(Groupings...),
+	}
+
+	for name, completion := range completions {
+		if cmd.Flags().Lookup(name) == nil {
+			continue
+		}
+
+		cmd.RegisterFlagCompletionFunc(name, completion)
+	}
+}
+
+// Views completes the column presets or, if templates is true, the go-templates of the configured view of the command
+func Views(command string, templates bool) CompletionFunc {
+	return func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		view := config.NewResolver(config.LoadConfig()).View(command)
+
+		values := view.Columns
+		if templates {
+			values = view.Templates
+		}
+
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
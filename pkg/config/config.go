@@ -20,9 +20,39 @@ type PolicyReporter struct {
 	Port      int    `mapstructure:"port"`
 }
 
+type Score struct {
+	Weights map[string]float64 `mapstructure:"weights"`
+}
+
+type Remediation struct {
+	File string `mapstructure:"file"`
+}
+
+type Vulnerabilities struct {
+	Sources []string `mapstructure:"sources"`
+}
+
+// Names of the commands with configurable views
+const (
+	ResultsView        = "results"
+	ClusterResultsView = "cluster-results"
+	TargetsView        = "targets"
+)
+
+// View configures the named column presets and go-templates of a command, Default is the preset used without --columns or -o
+type View struct {
+	Default   string            `mapstructure:"default"`
+	Columns   map[string]string `mapstructure:"columns"`
+	Templates map[string]string `mapstructure:"templates"`
+}
+
 // Config of the PolicyReporter
 type Config struct {
-	PolicyReporter PolicyReporter `mapstructure:"policyreporter"`
+	PolicyReporter  PolicyReporter  `mapstructure:"policyreporter"`
+	Score           Score           `mapstructure:"score"`
+	Remediation     Remediation     `mapstructure:"remediation"`
+	Vulnerabilities Vulnerabilities `mapstructure:"vulnerabilities"`
+	Views           map[string]View `mapstructure:"views"`
 }
 
 func LoadConfig() *Config {
@@ -30,6 +60,11 @@ func LoadConfig() *Config {
 	v.SetDefault("policyreporter.service", "svc/policy-reporter")
 	v.SetDefault("policyreporter.namespace", "policy-reporter")
 	v.SetDefault("policyreporter.port", 8080)
+	v.SetDefault("vulnerabilities.sources", []string{"re:(?i)vulnerab"})
+
+	v.SetConfigName("config")
+	v.SetConfigType("yaml")
+	v.AddConfigPath("$HOME/.polr")
 
 	v.AutomaticEnv()
 	v.ReadInConfig()
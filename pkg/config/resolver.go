@@ -3,14 +3,19 @@ package config
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/kyverno/policy-reporter-cli/pkg/forwarder"
 	"github.com/kyverno/policy-reporter-cli/pkg/k8s"
 	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/remediation"
 
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
@@ -66,8 +71,8 @@ func (r *Resolver) ForwardPolicyReporter(ctx context.Context) (*policyreporter.F
 
 	conn, err := policyreporter.Forward(ctx, options, kubeConfig)
 	if err == forwarder.ErrServiceNotFound {
-		fmt.Printf("Unable to connect to Policy Reporter with http://%s.%s:%d\n", strings.Split(prc.Service, "/")[1], prc.Namespace, prc.Port)
-		fmt.Printf("Use the following env variables '%s', '%s', '%s' to customize your configuration\n", PolicyReporterNamespacEnv, PolicyReporterServiceEnv, PolicyReporterPortEnv)
+		fmt.Fprintf(os.Stderr, "Unable to connect to Policy Reporter with http://%s.%s:%d\n", strings.Split(prc.Service, "/")[1], prc.Namespace, prc.Port)
+		fmt.Fprintf(os.Stderr, "Use the following env variables '%s', '%s', '%s' to customize your configuration\n", PolicyReporterNamespacEnv, PolicyReporterServiceEnv, PolicyReporterPortEnv)
 	}
 
 	return conn, err
@@ -94,7 +99,24 @@ func (r *Resolver) K8sClient() (k8s.Client, error) {
 		return nil, err
 	}
 
-	return k8s.NewClient(client), nil
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	return k8s.NewClient(client, mapper), nil
+}
+
+// Remediations loads the built-in remediations extended by the configured remediation file
+func (r *Resolver) Remediations() (*remediation.Catalogue, error) {
+	return remediation.Load(os.ExpandEnv(r.config.Remediation.File))
+}
+
+// View returns the configured column presets and templates of the command
+func (r *Resolver) View(command string) View {
+	return r.config.Views[command]
 }
 
 func NewResolver(config *Config) *Resolver {
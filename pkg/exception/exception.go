@@ -0,0 +1,328 @@
+package exception
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultAPIVersion of the generated Kyverno PolicyExceptions
+const DefaultAPIVersion = "kyverno.io/v2beta1"
+
+// DefaultExpiryAnnotation stores the expiry time of an exception, it is informational and not evaluated by Kyverno
+const DefaultExpiryAnnotation = "policy-reporter.io/expires"
+
+// Scope of a single PolicyException
+type Scope = string
+
+const (
+	ResourceScope  Scope = "resource"
+	NamespaceScope Scope = "namespace"
+)
+
+// Match mode of the resources
+type Match = string
+
+const (
+	NameMatch  Match = "names"
+	LabelMatch Match = "labels"
+)
+
+// ErrMissingLabelKeys is returned if resources should be matched by labels without label keys
+var ErrMissingLabelKeys = errors.New("matching by labels requires at least one label key")
+
+// LabelFunc loads the labels of the resource of a result
+type LabelFunc = func(result policyreporter.PolicyReportResult) (map[string]string, error)
+
+// Options to generate PolicyExceptions
+type Options struct {
+	APIVersion       string
+	Scope            Scope
+	Match            Match
+	LabelKeys        []string
+	Namespace        string
+	NamePrefix       string
+	Expires          time.Time
+	ExpiryAnnotation string
+}
+
+type Metadata struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type Exception struct {
+	PolicyName string   `json:"policyName"`
+	RuleNames  []string `json:"ruleNames"`
+}
+
+type Selector struct {
+	MatchLabels map[string]string `json:"matchLabels"`
+}
+
+type ResourceFilter struct {
+	Kinds      []string  `json:"kinds"`
+	Namespaces []string  `json:"namespaces,omitempty"`
+	Names      []string  `json:"names,omitempty"`
+	Selector   *Selector `json:"selector,omitempty"`
+}
+
+type ResourceDescription struct {
+	Resources ResourceFilter `json:"resources"`
+}
+
+type MatchResources struct {
+	Any []ResourceDescription `json:"any"`
+}
+
+type Spec struct {
+	Exceptions []Exception    `json:"exceptions"`
+	Match      MatchResources `json:"match"`
+}
+
+// PolicyException is a Kyverno PolicyException manifest
+type PolicyException struct {
+	APIVersion string   `json:"apiVersion"`
+	Kind       string   `json:"kind"`
+	Metadata   Metadata `json:"metadata"`
+	Spec       Spec     `json:"spec"`
+}
+
+var invalidName = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// Generate creates a PolicyException per resource or namespace containing all policy/rule pairs of its results
+func Generate(results []policyreporter.PolicyReportResult, labels LabelFunc, options Options) ([]PolicyException, error) {
+	if options.Scope != ResourceScope && options.Scope != NamespaceScope {
+		return nil, fmt.Errorf("unsupported scope %q, expected one of: %s, %s", options.Scope, ResourceScope, NamespaceScope)
+	}
+
+	if options.Match != NameMatch && options.Match != LabelMatch {
+		return nil, fmt.Errorf("unsupported match %q, expected one of: %s, %s", options.Match, NameMatch, LabelMatch)
+	}
+
+	if options.Match == LabelMatch && len(options.LabelKeys) == 0 {
+		return nil, ErrMissingLabelKeys
+	}
+
+	groups := make(map[string][]policyreporter.PolicyReportResult)
+	keys := make([]string, 0)
+
+	for _, result := range results {
+		key := result.Namespace
+		if options.Scope == ResourceScope {
+			key = fmt.Sprintf("%s/%s/%s", result.Namespace, result.Kind, result.Name)
+		}
+
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], result)
+	}
+
+	sort.Strings(keys)
+
+	exceptions := make([]PolicyException, 0, len(keys))
+	for _, key := range keys {
+		exception, err := newException(groups[key], labels, options)
+		if err != nil {
+			return nil, err
+		}
+
+		exceptions = append(exceptions, exception)
+	}
+
+	return exceptions, nil
+}
+
+func newException(results []policyreporter.PolicyReportResult, labels LabelFunc, options Options) (PolicyException, error) {
+	first := results[0]
+
+	name := first.Namespace
+	if options.Scope == ResourceScope {
+		name = fmt.Sprintf("%s-%s-%s", first.Namespace, first.Kind, first.Name)
+	}
+
+	namespace := options.Namespace
+	if namespace == "" {
+		namespace = first.Namespace
+	}
+
+	exception := PolicyException{
+		APIVersion: options.APIVersion,
+		Kind:       "PolicyException",
+		Metadata: Metadata{
+			Name:      resourceName(options.NamePrefix + name),
+			Namespace: namespace,
+		},
+	}
+
+	if !options.Expires.IsZero() {
+		exception.Metadata.Annotations = map[string]string{options.ExpiryAnnotation: options.Expires.UTC().Format(time.RFC3339)}
+	}
+
+	exception.Spec.Exceptions = policyRules(results)
+
+	filters, err := resourceFilters(results, labels, options)
+	if err != nil {
+		return exception, err
+	}
+
+	exception.Spec.Match.Any = filters
+
+	return exception, nil
+}
+
+// policyRules returns the sorted, unique rules per policy
+func policyRules(results []policyreporter.PolicyReportResult) []Exception {
+	rules := make(map[string]map[string]bool)
+	for _, result := range results {
+		if _, ok := rules[result.Policy]; !ok {
+			rules[result.Policy] = make(map[string]bool)
+		}
+
+		rules[result.Policy][result.Rule] = true
+	}
+
+	exceptions := make([]Exception, 0, len(rules))
+	for policy, names := range rules {
+		exception := Exception{PolicyName: policy, RuleNames: make([]string, 0, len(names))}
+		for rule := range names {
+			exception.RuleNames = append(exception.RuleNames, rule)
+		}
+
+		sort.Strings(exception.RuleNames)
+		exceptions = append(exceptions, exception)
+	}
+
+	sort.Slice(exceptions, func(i, j int) bool {
+		return exceptions[i].PolicyName < exceptions[j].PolicyName
+	})
+
+	return exceptions
+}
+
+// resourceFilters matches the resources of the results by kind and name or by the configured label keys
+func resourceFilters(results []policyreporter.PolicyReportResult, labels LabelFunc, options Options) ([]ResourceDescription, error) {
+	filters := make(map[string]*ResourceFilter)
+	keys := make([]string, 0)
+	seen := make(map[string]bool)
+
+	for _, result := range results {
+		resource := fmt.Sprintf("%s/%s/%s", result.Namespace, result.Kind, result.Name)
+		if seen[resource] {
+			continue
+		}
+		seen[resource] = true
+
+		var selector *Selector
+		key := fmt.Sprintf("%s/%s", result.Namespace, result.Kind)
+
+		if options.Match == LabelMatch {
+			values, err := labels(result)
+			if err != nil {
+				return nil, err
+			}
+
+			selector = &Selector{MatchLabels: make(map[string]string)}
+			for _, label := range options.LabelKeys {
+				value, ok := values[label]
+				if !ok {
+					return nil, fmt.Errorf("label %q not found on %s %s", label, result.Kind, result.Name)
+				}
+
+				selector.MatchLabels[label] = value
+				key = fmt.Sprintf("%s/%s=%s", key, label, value)
+			}
+		}
+
+		filter, ok := filters[key]
+		if !ok {
+			filter = &ResourceFilter{Kinds: []string{result.Kind}, Selector: selector}
+			if result.Namespace != "" {
+				filter.Namespaces = []string{result.Namespace}
+			}
+
+			filters[key] = filter
+			keys = append(keys, key)
+		}
+
+		if selector == nil {
+			filter.Names = append(filter.Names, result.Name)
+		}
+	}
+
+	sort.Strings(keys)
+
+	list := make([]ResourceDescription, 0, len(keys))
+	for _, key := range keys {
+		sort.Strings(filters[key].Names)
+		list = append(list, ResourceDescription{Resources: *filters[key]})
+	}
+
+	return list, nil
+}
+
+// resourceName converts the value into a valid Kubernetes resource name
+func resourceName(value string) string {
+	name := strings.Trim(invalidName.ReplaceAllString(strings.ToLower(value), "-"), "-")
+	if len(name) > 253 {
+		name = strings.Trim(name[:253], "-")
+	}
+
+	return name
+}
+
+// Write prints the PolicyExceptions as multi document YAML
+func Write(w io.Writer, exceptions []PolicyException) error {
+	for i, exception := range exceptions {
+		content, err := yaml.Marshal(exception)
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			fmt.Fprintln(w, "---")
+		}
+
+		if _, err := w.Write(content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WriteFiles writes each PolicyException into a separate <name>.yaml file in the directory and returns the file paths
+func WriteFiles(dir string, exceptions []PolicyException) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	files := make([]string, 0, len(exceptions))
+	for _, exception := range exceptions {
+		content, err := yaml.Marshal(exception)
+		if err != nil {
+			return files, err
+		}
+
+		file := filepath.Join(dir, exception.Metadata.Name+".yaml")
+		if err := os.WriteFile(file, content, 0o644); err != nil {
+			return files, err
+		}
+
+		files = append(files, file)
+	}
+
+	return files, nil
+}
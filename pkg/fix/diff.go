@@ -0,0 +1,142 @@
+package fix
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change
+const diffContext = 3
+
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// Diff writes a unified diff of the two texts, nothing is written for identical texts
+func Diff(w io.Writer, name, before, after string) {
+	lines := diffLines(strings.Split(strings.TrimSuffix(before, "\n"), "\n"), strings.Split(strings.TrimSuffix(after, "\n"), "\n"))
+
+	changed := false
+	for _, line := range lines {
+		if line.kind != ' ' {
+			changed = true
+			break
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	fmt.Fprintf(w, "--- %s\n+++ %s (patched)\n", name, name)
+
+	for start := 0; start < len(lines); {
+		if lines[start].kind == ' ' {
+			start++
+			continue
+		}
+
+		from := start - diffContext
+		if from < 0 {
+			from = 0
+		}
+
+		to := start
+		for unchanged := 0; to < len(lines) && unchanged <= 2*diffContext; to++ {
+			if lines[to].kind == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		to = trimContext(lines, to)
+		if to > len(lines) {
+			to = len(lines)
+		}
+
+		oldStart, newStart := count(lines[:from])
+		oldCount, newCount := count(lines[from:to])
+
+		fmt.Fprintf(w, "@@ -%d,%d +%d,%d @@\n", oldStart+1, oldCount, newStart+1, newCount)
+		for _, line := range lines[from:to] {
+			fmt.Fprintf(w, "%c%s\n", line.kind, line.text)
+		}
+
+		start = to
+	}
+}
+
+// diffLines computes the longest common subsequence of both line lists
+func diffLines(a, b []string) []diffLine {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else if lengths[i+1][j] >= lengths[i][j+1] {
+				lengths[i][j] = lengths[i+1][j]
+			} else {
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b))
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i]})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			lines = append(lines, diffLine{'-', a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j]})
+			j++
+		}
+	}
+
+	for ; i < len(a); i++ {
+		lines = append(lines, diffLine{'-', a[i]})
+	}
+
+	for ; j < len(b); j++ {
+		lines = append(lines, diffLine{'+', b[j]})
+	}
+
+	return lines
+}
+
+// trimContext reduces the trailing unchanged lines of a hunk to the context size
+func trimContext(lines []diffLine, end int) int {
+	last := end - 1
+	for last >= 0 && lines[last].kind == ' ' {
+		last--
+	}
+
+	return last + 1 + diffContext
+}
+
+// count returns the number of lines of the old and the new text
+func count(lines []diffLine) (int, int) {
+	before, after := 0, 0
+	for _, line := range lines {
+		if line.kind != '+' {
+			before++
+		}
+		if line.kind != '-' {
+			after++
+		}
+	}
+
+	return before, after
+}
@@ -0,0 +1,361 @@
+package fix
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// ErrUnsupportedKind is returned for resources without a pod spec
+var ErrUnsupportedKind = errors.New("kind has no pod spec")
+
+// Operation of a RFC 6902 JSON patch
+type Operation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+// Rule is a supported policy rule with the function to fix the pod spec
+type Rule struct {
+	Policy string
+	Rule   string
+	fix    func(p *patcher, spec []interface{})
+}
+
+// Rules of the Kyverno Pod Security Standards policies with a mechanical fix
+var Rules = []Rule{
+	{Policy: "require-run-as-nonroot", Rule: "run-as-non-root", fix: runAsNonRoot},
+	{Policy: "disallow-capabilities-strict", Rule: "require-drop-all", fix: dropAllCapabilities},
+	{Policy: "restrict-seccomp", Rule: "check-seccomp", fix: seccompRuntimeDefault},
+	{Policy: "restrict-seccomp-strict", Rule: "check-seccomp-strict", fix: seccompRuntimeDefault},
+	{Policy: "disallow-privilege-escalation", Rule: "privilege-escalation", fix: disallowPrivilegeEscalation},
+}
+
+// Policies returns the unique names of all policies with supported rules
+func Policies() []string {
+	unique := make(map[string]bool, len(Rules))
+	list := make([]string, 0, len(Rules))
+
+	for _, rule := range Rules {
+		if !unique[rule.Policy] {
+			unique[rule.Policy] = true
+			list = append(list, rule.Policy)
+		}
+	}
+
+	sort.Strings(list)
+
+	return list
+}
+
+// Lookup returns the supported rule of the result, autogen rules of Pod controllers are mapped to their Pod rule
+func Lookup(result policyreporter.PolicyReportResult) (Rule, bool) {
+	name := strings.TrimPrefix(strings.TrimPrefix(result.Rule, "autogen-cronjob-"), "autogen-")
+
+	for _, rule := range Rules {
+		if rule.Policy == result.Policy && rule.Rule == name {
+			return rule, true
+		}
+	}
+
+	return Rule{}, false
+}
+
+// Patch of a single resource
+type Patch struct {
+	Rules      []string
+	Operations []Operation
+	Patched    map[string]interface{}
+}
+
+// Compute applies the supported rules of the results to a copy of the manifest and returns the patch operations
+func Compute(manifest map[string]interface{}, results []policyreporter.PolicyReportResult) (Patch, error) {
+	kind, _ := manifest["kind"].(string)
+
+	spec, ok := podSpecPath(kind)
+	if !ok {
+		return Patch{}, fmt.Errorf("%w: %s", ErrUnsupportedKind, kind)
+	}
+
+	p := &patcher{object: deepCopy(manifest).(map[string]interface{})}
+	applied := make(map[string]bool)
+	rules := make([]string, 0)
+
+	for _, result := range results {
+		rule, ok := Lookup(result)
+		if !ok {
+			continue
+		}
+
+		name := fmt.Sprintf("%s/%s", rule.Policy, rule.Rule)
+		if applied[name] {
+			continue
+		}
+		applied[name] = true
+
+		if _, ok := p.get(spec); !ok {
+			continue
+		}
+
+		rule.fix(p, spec)
+		rules = append(rules, name)
+	}
+
+	sort.Strings(rules)
+
+	return Patch{Rules: rules, Operations: p.operations, Patched: p.object}, nil
+}
+
+// podSpecPath returns the path of the pod spec within the supported kinds
+func podSpecPath(kind string) ([]interface{}, bool) {
+	switch kind {
+	case "Pod":
+		return []interface{}{"spec"}, true
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "ReplicationController", "Job":
+		return []interface{}{"spec", "template", "spec"}, true
+	case "CronJob":
+		return []interface{}{"spec", "jobTemplate", "spec", "template", "spec"}, true
+	}
+
+	return nil, false
+}
+
+var containerFields = []string{"initContainers", "containers", "ephemeralContainers"}
+
+// containers returns the paths of all containers of the pod spec
+func containers(p *patcher, spec []interface{}) [][]interface{} {
+	paths := make([][]interface{}, 0)
+
+	for _, field := range containerFields {
+		value, _ := p.get(join(spec, field))
+		list, _ := value.([]interface{})
+
+		for i := range list {
+			paths = append(paths, join(spec, field, i))
+		}
+	}
+
+	return paths
+}
+
+func runAsNonRoot(p *patcher, spec []interface{}) {
+	p.set(join(spec, "securityContext", "runAsNonRoot"), true)
+
+	for _, container := range containers(p, spec) {
+		path := join(container, "securityContext", "runAsNonRoot")
+		if value, ok := p.get(path); ok && value != true {
+			p.set(path, true)
+		}
+	}
+}
+
+func dropAllCapabilities(p *patcher, spec []interface{}) {
+	for _, container := range containers(p, spec) {
+		path := join(container, "securityContext", "capabilities", "drop")
+
+		value, ok := p.get(path)
+		if !ok {
+			p.set(path, []interface{}{"ALL"})
+			continue
+		}
+
+		list, _ := value.([]interface{})
+		if !contains(list, "ALL") {
+			p.append(path, "ALL")
+		}
+	}
+}
+
+func seccompRuntimeDefault(p *patcher, spec []interface{}) {
+	allowed := func(path []interface{}) bool {
+		value, _ := p.get(path)
+		return value == "RuntimeDefault" || value == "Localhost"
+	}
+
+	path := join(spec, "securityContext", "seccompProfile", "type")
+	if !allowed(path) {
+		p.set(join(spec, "securityContext", "seccompProfile"), map[string]interface{}{"type": "RuntimeDefault"})
+	}
+
+	for _, container := range containers(p, spec) {
+		path := join(container, "securityContext", "seccompProfile", "type")
+		if _, ok := p.get(path); ok && !allowed(path) {
+			p.set(join(container, "securityContext", "seccompProfile"), map[string]interface{}{"type": "RuntimeDefault"})
+		}
+	}
+}
+
+func disallowPrivilegeEscalation(p *patcher, spec []interface{}) {
+	for _, container := range containers(p, spec) {
+		p.set(join(container, "securityContext", "allowPrivilegeEscalation"), false)
+	}
+}
+
+// patcher changes the object and records the changes as JSON patch operations
+type patcher struct {
+	object     map[string]interface{}
+	operations []Operation
+}
+
+// get returns the value of the path, segments are map keys or list indices
+func (p *patcher) get(path []interface{}) (interface{}, bool) {
+	var current interface{} = p.object
+
+	for _, segment := range path {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			key, _ := segment.(string)
+			child, ok := value[key]
+			if !ok {
+				return nil, false
+			}
+			current = child
+		case []interface{}:
+			index, ok := segment.(int)
+			if !ok || index < 0 || index >= len(value) {
+				return nil, false
+			}
+			current = value[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// set adds or replaces the value, missing parent objects are added together with the value
+func (p *patcher) set(path []interface{}, value interface{}) {
+	current, exists := p.get(path)
+	if exists && reflect.DeepEqual(current, value) {
+		return
+	}
+
+	var parent interface{} = p.object
+	for i, segment := range path[:len(path)-1] {
+		child, ok := p.get(path[:i+1])
+		if !ok || child == nil {
+			nested := wrap(path[i+1:], value)
+			parent.(map[string]interface{})[segment.(string)] = nested
+			p.record("add", path[:i+1], nested)
+			return
+		}
+
+		parent = child
+	}
+
+	op := "add"
+	if exists {
+		op = "replace"
+	}
+
+	parent.(map[string]interface{})[path[len(path)-1].(string)] = value
+	p.record(op, path, value)
+}
+
+// append adds the value to the end of the list
+func (p *patcher) append(path []interface{}, value interface{}) {
+	current, _ := p.get(path)
+	list, _ := current.([]interface{})
+
+	parent, _ := p.get(path[:len(path)-1])
+	parent.(map[string]interface{})[path[len(path)-1].(string)] = append(list, value)
+
+	p.record("add", join(path, "-"), value)
+}
+
+func (p *patcher) record(op string, path []interface{}, value interface{}) {
+	p.operations = append(p.operations, Operation{Op: op, Path: pointer(path), Value: deepCopy(value)})
+}
+
+// pointer converts the path into a JSON pointer
+func pointer(path []interface{}) string {
+	var builder strings.Builder
+
+	for _, segment := range path {
+		builder.WriteString("/")
+
+		switch value := segment.(type) {
+		case string:
+			builder.WriteString(strings.ReplaceAll(strings.ReplaceAll(value, "~", "~0"), "/", "~1"))
+		case int:
+			builder.WriteString(fmt.Sprint(value))
+		}
+	}
+
+	return builder.String()
+}
+
+// wrap nests the value in objects for the remaining path
+func wrap(path []interface{}, value interface{}) interface{} {
+	for i := len(path) - 1; i >= 0; i-- {
+		value = map[string]interface{}{path[i].(string): value}
+	}
+
+	return value
+}
+
+func join(path []interface{}, segments ...interface{}) []interface{} {
+	result := make([]interface{}, 0, len(path)+len(segments))
+	result = append(result, path...)
+
+	return append(result, segments...)
+}
+
+func contains(list []interface{}, value interface{}) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = deepCopy(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = deepCopy(item)
+		}
+		return result
+	}
+
+	return value
+}
+
+// Clean removes the fields managed by the API server from the manifest, so that it can be applied again
+func Clean(manifest map[string]interface{}) map[string]interface{} {
+	result := deepCopy(manifest).(map[string]interface{})
+	delete(result, "status")
+
+	metadata, ok := result["metadata"].(map[string]interface{})
+	if !ok {
+		return result
+	}
+
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "generation", "creationTimestamp", "selfLink"} {
+		delete(metadata, field)
+	}
+
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, "kubectl.kubernetes.io/last-applied-configuration")
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+
+	return result
+}
@@ -3,8 +3,8 @@ package k8s
 import (
 	"context"
 	"fmt"
-	"log"
-	"strings"
+	"os"
+	"sort"
 
 	pr "github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -15,107 +15,199 @@ import (
 )
 
 type Client interface {
-	LabelFilter(ctx context.Context, results pr.ResultList, labels string) pr.ResultList
+	ResourceFilter(ctx context.Context, results pr.ResultList, selector ResourceSelector) (pr.ResultList, error)
+	Namespaces(ctx context.Context, selector string) ([]string, error)
+	OwnerRollup(ctx context.Context, results pr.ResultList) pr.ResultList
+	Owners(ctx context.Context, namespace string, resource Owner) ([]Owner, error)
+	Controller(ctx context.Context, namespace string, resource Owner) (*Owner, error)
+	Labels(ctx context.Context, namespace string, resource Owner) (map[string]string, error)
+	Get(ctx context.Context, namespace string, resource Owner) (*unstructured.Unstructured, error)
 }
 
 type k8sClient struct {
 	client dynamic.Interface
+	mapper meta.RESTMapper
 }
 
-func (k *k8sClient) LabelFilter(ctx context.Context, results pr.ResultList, labels string) pr.ResultList {
+// ResourceFilter keeps all results whose resource matches the label, field and annotation selectors.
+// Results of kinds which can not be mapped to an API resource or fetched are excluded with a warning.
+func (k *k8sClient) ResourceFilter(ctx context.Context, results pr.ResultList, selector ResourceSelector) (pr.ResultList, error) {
+	if selector.Empty() {
+		return results, nil
+	}
+
+	annotations, err := ParseAnnotationSelector(selector.Annotations)
+	if err != nil {
+		return results, err
+	}
+
+	options := v1.ListOptions{LabelSelector: selector.Labels, FieldSelector: selector.Fields}
+
 	groups := make(map[string][]pr.PolicyReportResult, 0)
-	filtered := make([]pr.PolicyReportResult, 0, results.Count)
+	keys := make([]string, 0)
 
 	for _, res := range results.Items {
 		key := resKey(res)
-		if list, ok := groups[key]; ok {
-			groups[key] = append(list, res)
-		} else {
-			groups[key] = []pr.PolicyReportResult{res}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
 		}
+
+		groups[key] = append(groups[key], res)
 	}
 
-	for _, group := range groups {
-		resources, err := k.fetchResources(ctx, group[0].Kind, group[0].APIVersion, labels)
+	matched := make(map[string]bool, results.Count)
+
+	for _, key := range keys {
+		group := groups[key]
+
+		resources, err := k.fetchResources(ctx, group, options, annotations)
 		if err != nil {
-			log.Printf("%s/%s: %s", group[0].APIVersion, group[0].Kind, err)
+			fmt.Fprintf(os.Stderr, "[WARNING] %s, %d results of %s are excluded\n", err, len(group), key)
 			continue
 		}
 
-		list := k.filter(group, resources)
+		for _, res := range group {
+			if resources[nameKey(res.Namespace, res.Name)] {
+				matched[res.ID+resultKey(res)] = true
+			}
+		}
+	}
 
-		filtered = append(filtered, list...)
+	filtered := make([]pr.PolicyReportResult, 0, len(matched))
+	for _, res := range results.Items {
+		if matched[res.ID+resultKey(res)] {
+			filtered = append(filtered, res)
+		}
 	}
 
-	return pr.ResultList{Items: filtered, Count: len(filtered)}
+	return pr.ResultList{Items: filtered, Count: len(filtered)}, nil
 }
 
-func (k *k8sClient) fetchResources(ctx context.Context, kind, apiVersion, labels string) (*unstructured.UnstructuredList, error) {
-	var group, version string
+// Namespaces returns the names of all namespaces matching the label selector
+func (k *k8sClient) Namespaces(ctx context.Context, selector string) ([]string, error) {
+	list, err := k.client.Resource(schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}).List(ctx, v1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
 
-	parts := strings.Split(apiVersion, "/")
-	if len(parts) == 2 {
-		group = strings.TrimSpace(parts[0])
-		version = strings.TrimSpace(parts[1])
-	} else if len(parts) == 1 {
-		version = strings.TrimSpace(parts[0])
-	} else {
-		return nil, fmt.Errorf("Invalid apiVersion: %s", apiVersion)
+	namespaces := make([]string, 0, len(list.Items))
+	for _, namespace := range list.Items {
+		namespaces = append(namespaces, namespace.GetName())
 	}
 
-	// @TODO Check for more stable solutions
-	resource, _ := meta.UnsafeGuessKindToResource(schema.GroupVersionKind{Group: group, Version: version, Kind: strings.ToLower(kind)})
+	return namespaces, nil
+}
+
+// Labels returns the labels of a single resource
+func (k *k8sClient) Labels(ctx context.Context, namespace string, resource Owner) (map[string]string, error) {
+	item, err := k.Get(ctx, namespace, resource)
+	if err != nil {
+		return nil, err
+	}
 
-	return k.client.Resource(resource).List(ctx, v1.ListOptions{LabelSelector: labels})
+	return item.GetLabels(), nil
 }
 
-func (k *k8sClient) filter(results []pr.PolicyReportResult, list *unstructured.UnstructuredList) []pr.PolicyReportResult {
-	filtered := make([]pr.PolicyReportResult, 0, len(results))
+// Get fetches the current manifest of the resource
+func (k *k8sClient) Get(ctx context.Context, namespace string, resource Owner) (*unstructured.Unstructured, error) {
+	mapping, err := k.mapping(resource.Kind, resource.APIVersion)
+	if err != nil {
+		return nil, err
+	}
 
-	resources := make([]string, 0, len(list.Items))
-	for _, resource := range list.Items {
-		metadata, ok := resource.Object["metadata"].(map[string]interface{})
-		if !ok {
-			continue
+	item, err := k.client.Resource(mapping.Resource).Namespace(namespace).Get(ctx, resource.Name, v1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get %s %s: %w", mapping.Resource.Resource, resource.Name, err)
+	}
+
+	return item, nil
+}
+
+// mapping resolves the API resource of the given kind via discovery
+func (k *k8sClient) mapping(kind, apiVersion string) (*meta.RESTMapping, error) {
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid apiVersion %q: %w", apiVersion, err)
+	}
+
+	mapping, err := k.mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: kind}, gv.Version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to map kind %s/%s to an API resource: %w", apiVersion, kind, err)
+	}
+
+	return mapping, nil
+}
+
+// fetchResources lists the resources of a single kind matching the selectors, scoped to the namespaces of the results
+// and returns the set of their namespace/name keys
+func (k *k8sClient) fetchResources(ctx context.Context, results []pr.PolicyReportResult, options v1.ListOptions, annotations AnnotationSelector) (map[string]bool, error) {
+	mapping, err := k.mapping(results[0].Kind, results[0].APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := k.client.Resource(mapping.Resource)
+	names := make(map[string]bool)
+
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		list, err := resource.List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s: %w", mapping.Resource.Resource, err)
 		}
-		name := toString(metadata["name"])
 
-		if name != "" {
-			resources = append(resources, name)
+		for _, item := range list.Items {
+			if annotations.Matches(item.GetAnnotations()) {
+				names[nameKey("", item.GetName())] = true
+			}
 		}
+
+		return names, nil
 	}
 
-	for _, res := range results {
-		if contains(res.Name, resources) {
-			filtered = append(filtered, res)
+	for _, namespace := range namespaces(results) {
+		list, err := resource.Namespace(namespace).List(ctx, options)
+		if err != nil {
+			return nil, fmt.Errorf("unable to list %s in namespace %s: %w", mapping.Resource.Resource, namespace, err)
+		}
+
+		for _, item := range list.Items {
+			if annotations.Matches(item.GetAnnotations()) {
+				names[nameKey(item.GetNamespace(), item.GetName())] = true
+			}
 		}
 	}
 
-	return filtered
+	return names, nil
 }
 
-func toString(value interface{}) string {
-	if v, ok := value.(string); ok {
-		return v
+func namespaces(results []pr.PolicyReportResult) []string {
+	set := make(map[string]struct{})
+	for _, res := range results {
+		set[res.Namespace] = struct{}{}
 	}
 
-	return ""
+	list := make([]string, 0, len(set))
+	for namespace := range set {
+		list = append(list, namespace)
+	}
+
+	sort.Strings(list)
+
+	return list
 }
 
 func resKey(value pr.PolicyReportResult) string {
 	return fmt.Sprintf("%s/%s", value.APIVersion, value.Kind)
 }
 
-func contains(name string, names []string) bool {
-	for _, s := range names {
-		if strings.EqualFold(s, name) {
-			return true
-		}
-	}
+func nameKey(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
 
-	return false
+func resultKey(value pr.PolicyReportResult) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s", value.Namespace, value.Kind, value.Name, value.Policy, value.Rule)
 }
 
-func NewClient(client dynamic.Interface) Client {
-	return &k8sClient{client}
+func NewClient(client dynamic.Interface, mapper meta.RESTMapper) Client {
+	return &k8sClient{client, mapper}
 }
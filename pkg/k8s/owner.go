@@ -0,0 +1,188 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	pr "github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaxOwnerDepth prevents endless loops on cyclic ownerReferences
+const MaxOwnerDepth = 10
+
+// Owner is the top level controller of a resource
+type Owner struct {
+	APIVersion string
+	Kind       string
+	Name       string
+}
+
+// OwnerRollup replaces the resource of each result with its top level controller and deduplicates identical findings,
+// Replicas contains the number of Pods (or Jobs of a CronJob) affected by a finding.
+func (k *k8sClient) OwnerRollup(ctx context.Context, results pr.ResultList) pr.ResultList {
+	owners := make(map[string]Owner, 0)
+	roots := make(map[string]Owner, 0)
+	failed := make(map[string]bool, 0)
+
+	for _, res := range results.Items {
+		key := ResourceKey(res)
+		if _, ok := owners[key]; ok {
+			continue
+		}
+
+		owner, err := k.rootOwner(ctx, res.Namespace, Owner{APIVersion: res.APIVersion, Kind: res.Kind, Name: res.Name}, roots)
+		if err != nil && !failed[resKey(res)] {
+			failed[resKey(res)] = true
+			fmt.Fprintf(os.Stderr, "[WARNING] %s, results of %s are not rolled up\n", err, resKey(res))
+		}
+
+		owners[key] = owner
+	}
+
+	return RollupResults(results, owners)
+}
+
+// rootOwner follows the controller ownerReferences of the resource up to its top level controller.
+// The root of each resolved resource, including intermediate owners, is cached in roots,
+// so that resources with a common owner fetch the shared part of their chain only once.
+// If a resource can not be fetched, the last resolved resource is returned with the error.
+func (k *k8sClient) rootOwner(ctx context.Context, namespace string, resource Owner, roots map[string]Owner) (Owner, error) {
+	chain := make([]string, 0)
+
+	var err error
+	for i := 0; i < MaxOwnerDepth; i++ {
+		key := ownerKey(namespace, resource)
+		if root, ok := roots[key]; ok {
+			resource = root
+			break
+		}
+
+		chain = append(chain, key)
+
+		var controller *Owner
+		controller, err = k.Controller(ctx, namespace, resource)
+		if err != nil || controller == nil {
+			break
+		}
+
+		resource = *controller
+	}
+
+	for _, key := range chain {
+		roots[key] = resource
+	}
+
+	return resource, err
+}
+
+// Owners returns the chain of controllers of the resource, starting with its direct controller.
+// If a resource can not be fetched, the chain resolved so far is returned with the error.
+func (k *k8sClient) Owners(ctx context.Context, namespace string, resource Owner) ([]Owner, error) {
+	owners := make([]Owner, 0)
+
+	for i := 0; i < MaxOwnerDepth; i++ {
+		controller, err := k.Controller(ctx, namespace, resource)
+		if err != nil || controller == nil {
+			return owners, err
+		}
+
+		resource = *controller
+		owners = append(owners, resource)
+	}
+
+	return owners, nil
+}
+
+// Controller returns the direct controller of the resource, nil if the resource has no controller or does not exist
+func (k *k8sClient) Controller(ctx context.Context, namespace string, resource Owner) (*Owner, error) {
+	mapping, err := k.mapping(resource.Kind, resource.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := k.client.Resource(mapping.Resource).Namespace(namespace).Get(ctx, resource.Name, v1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to get %s %s: %w", mapping.Resource.Resource, resource.Name, err)
+	}
+
+	controller := v1.GetControllerOf(item)
+	if controller == nil {
+		return nil, nil
+	}
+
+	return &Owner{APIVersion: controller.APIVersion, Kind: controller.Kind, Name: controller.Name}, nil
+}
+
+// RollupResults maps each result to the Owner of its resource and merges results with the same policy, rule and status per Owner.
+// Autogen rules of Pod controllers are merged with the rule of their Pods, Replicas counts the affected leaf resources:
+// Jobs of a CronJob and Pods of any other Owner. Results without Owner are kept as they are.
+func RollupResults(results pr.ResultList, owners map[string]Owner) pr.ResultList {
+	rolledUp := make([]pr.PolicyReportResult, 0, len(results.Items))
+	index := make(map[string]int, 0)
+	affected := make(map[string]map[string]bool, 0)
+
+	for _, res := range results.Items {
+		resource := ResourceKey(res)
+		kind := res.Kind
+
+		if owner, ok := owners[resource]; ok {
+			res.APIVersion = owner.APIVersion
+			res.Kind = owner.Kind
+			res.Name = owner.Name
+		}
+
+		key := fmt.Sprintf("%s/%s/%s/%s", ResourceKey(res), res.Policy, baseRule(res.Rule), res.Status)
+
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(rolledUp)
+			affected[key] = make(map[string]bool, 0)
+
+			rolledUp = append(rolledUp, res)
+			i = len(rolledUp) - 1
+		} else if res.Timestamp > rolledUp[i].Timestamp {
+			rolledUp[i].Timestamp = res.Timestamp
+			rolledUp[i].TimeFormatted = res.TimeFormatted
+		}
+
+		if isLeaf(kind, res.Kind) {
+			affected[key][resource] = true
+		}
+
+		rolledUp[i].Replicas = len(affected[key])
+		if rolledUp[i].Replicas == 0 {
+			rolledUp[i].Replicas = 1
+		}
+	}
+
+	return pr.ResultList{Items: rolledUp, Count: len(rolledUp)}
+}
+
+// baseRule removes the prefixes of Kyverno autogen rules
+func baseRule(rule string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(rule, "autogen-cronjob-"), "autogen-")
+}
+
+// isLeaf reports if a resource of the kind is counted as replica of its root Owner
+func isLeaf(kind, root string) bool {
+	if root == "CronJob" {
+		return kind == "Job"
+	}
+
+	return kind == "Pod"
+}
+
+// ResourceKey identifies the resource of a result
+func ResourceKey(value pr.PolicyReportResult) string {
+	return ownerKey(value.Namespace, Owner{APIVersion: value.APIVersion, Kind: value.Kind, Name: value.Name})
+}
+
+func ownerKey(namespace string, owner Owner) string {
+	return fmt.Sprintf("%s/%s/%s/%s", namespace, owner.APIVersion, owner.Kind, owner.Name)
+}
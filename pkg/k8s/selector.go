@@ -0,0 +1,95 @@
+package k8s
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceSelector filters results by the labels, fields and annotations of their resources
+type ResourceSelector struct {
+	Labels      string
+	Fields      string
+	Annotations string
+}
+
+// Empty is true if no selector is configured
+func (s ResourceSelector) Empty() bool {
+	return s.Labels == "" && s.Fields == "" && s.Annotations == ""
+}
+
+type annotationRequirement struct {
+	key      string
+	value    string
+	operator string
+}
+
+func (r annotationRequirement) matches(annotations map[string]string) bool {
+	value, ok := annotations[r.key]
+
+	switch r.operator {
+	case "exists":
+		return ok
+	case "!exists":
+		return !ok
+	case "!=":
+		return !ok || value != r.value
+	default:
+		return ok && value == r.value
+	}
+}
+
+// AnnotationSelector matches the annotations of a resource against a list of requirements
+type AnnotationSelector []annotationRequirement
+
+// Matches is true if the annotations fulfill all requirements
+func (s AnnotationSelector) Matches(annotations map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.matches(annotations) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ParseAnnotationSelector parses a comma separated list of requirements, supports 'key', '!key', 'key=value', 'key==value' and 'key!=value'.
+// Other than label selectors, values are not validated because annotations may contain any string.
+func ParseAnnotationSelector(selector string) (AnnotationSelector, error) {
+	requirements := make(AnnotationSelector, 0)
+	if strings.TrimSpace(selector) == "" {
+		return requirements, nil
+	}
+
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+
+		var requirement annotationRequirement
+
+		switch {
+		case strings.Contains(part, "!="):
+			values := strings.SplitN(part, "!=", 2)
+			requirement = annotationRequirement{key: values[0], value: values[1], operator: "!="}
+		case strings.Contains(part, "=="):
+			values := strings.SplitN(part, "==", 2)
+			requirement = annotationRequirement{key: values[0], value: values[1], operator: "="}
+		case strings.Contains(part, "="):
+			values := strings.SplitN(part, "=", 2)
+			requirement = annotationRequirement{key: values[0], value: values[1], operator: "="}
+		case strings.HasPrefix(part, "!"):
+			requirement = annotationRequirement{key: strings.TrimPrefix(part, "!"), operator: "!exists"}
+		default:
+			requirement = annotationRequirement{key: part, operator: "exists"}
+		}
+
+		requirement.key = strings.TrimSpace(requirement.key)
+		requirement.value = strings.TrimSpace(requirement.value)
+
+		if requirement.key == "" {
+			return nil, fmt.Errorf("invalid annotation selector %q: missing key", selector)
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
@@ -47,8 +47,11 @@ type PolicyReportResult struct {
 	Rule          string            `json:"rule"`
 	Status        string            `json:"status"`
 	Severity      string            `json:"severity,omitempty"`
+	Source        string            `json:"source,omitempty"`
 	Properties    map[string]string `json:"properties,omitempty"`
 	Timestamp     int               `json:"timestamp,omitempty"`
+	Replicas      int               `json:"replicas,omitempty"`
+	Remediation   string            `json:"remediation,omitempty"`
 	TimeFormatted string
 }
 
@@ -0,0 +1,41 @@
+package policyreporter
+
+import (
+	"context"
+)
+
+// ResultFetcher is implemented by API.Results and API.ClusterResults
+type ResultFetcher = func(context.Context, Filter) (ResultList, error)
+
+// ResultsWithSource fetches the results separately for each source to set the Source of each result,
+// because the REST API does not return it as part of the result items.
+// The sources of the filter are preferred, sources is used if the filter has none.
+func ResultsWithSource(ctx context.Context, fetch ResultFetcher, filter Filter, sources []string) (ResultList, error) {
+	if len(filter.Sources) > 0 {
+		sources = filter.Sources
+	}
+
+	list := ResultList{Items: make([]PolicyReportResult, 0)}
+
+	for _, source := range sources {
+		sourceFilter := filter
+		sourceFilter.Sources = []string{source}
+
+		results, err := fetch(ctx, sourceFilter)
+		if err != nil {
+			return list, err
+		}
+
+		for _, result := range results.Items {
+			if result.Source == "" {
+				result.Source = source
+			}
+
+			list.Items = append(list.Items, result)
+		}
+	}
+
+	list.Count = len(list.Items)
+
+	return list, nil
+}
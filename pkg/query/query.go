@@ -0,0 +1,121 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// variables available in query expressions with their result field
+var variables = map[string]func(policyreporter.PolicyReportResult) interface{}{
+	"namespace":  func(r policyreporter.PolicyReportResult) interface{} { return r.Namespace },
+	"kind":       func(r policyreporter.PolicyReportResult) interface{} { return r.Kind },
+	"apiVersion": func(r policyreporter.PolicyReportResult) interface{} { return r.APIVersion },
+	"name":       func(r policyreporter.PolicyReportResult) interface{} { return r.Name },
+	"message":    func(r policyreporter.PolicyReportResult) interface{} { return r.Message },
+	"category":   func(r policyreporter.PolicyReportResult) interface{} { return r.Category },
+	"policy":     func(r policyreporter.PolicyReportResult) interface{} { return r.Policy },
+	"rule":       func(r policyreporter.PolicyReportResult) interface{} { return r.Rule },
+	"status":     func(r policyreporter.PolicyReportResult) interface{} { return r.Status },
+	"severity":   func(r policyreporter.PolicyReportResult) interface{} { return r.Severity },
+	"source":     func(r policyreporter.PolicyReportResult) interface{} { return r.Source },
+	"timestamp":  func(r policyreporter.PolicyReportResult) interface{} { return int64(r.Timestamp) },
+	"properties": func(r policyreporter.PolicyReportResult) interface{} {
+		if r.Properties == nil {
+			return map[string]string{}
+		}
+
+		return r.Properties
+	},
+}
+
+// Compile parses and checks a CEL expression over the fields of a PolicyReportResult, e.g.
+//
+//	status == "fail" && severity in ["high"] && properties.image.startsWith("docker.io")
+//
+// The returned function reports whether a result matches, results failing the evaluation
+// (e.g. because of a missing property) never match.
+func Compile(expression string) (func(policyreporter.PolicyReportResult) bool, error) {
+	env, ast, err := check(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %w", err)
+	}
+
+	return func(result policyreporter.PolicyReportResult) bool {
+		activation := make(map[string]interface{}, len(variables))
+		for name, value := range variables {
+			activation[name] = value(result)
+		}
+
+		out, _, err := program.Eval(activation)
+		if err != nil {
+			return false
+		}
+
+		matched, ok := out.Value().(bool)
+
+		return ok && matched
+	}, nil
+}
+
+// References reports whether the expression uses the variable, e.g. to fetch the source of the results only if it is queried.
+// Invalid expressions reference no variable.
+func References(expression, variable string) bool {
+	_, ast, err := check(expression)
+	if err != nil {
+		return false
+	}
+
+	checked, err := cel.AstToCheckedExpr(ast)
+	if err != nil {
+		return false
+	}
+
+	for _, reference := range checked.GetReferenceMap() {
+		if reference.GetName() == variable {
+			return true
+		}
+	}
+
+	return false
+}
+
+// check parses and type checks the expression with all variables
+func check(expression string) (*cel.Env, *cel.Ast, error) {
+	options := make([]cel.EnvOption, 0, len(variables))
+	for name := range variables {
+		var t *cel.Type
+		switch name {
+		case "timestamp":
+			t = cel.IntType
+		case "properties":
+			t = cel.MapType(cel.StringType, cel.StringType)
+		default:
+			t = cel.StringType
+		}
+
+		options = append(options, cel.Variable(name, t))
+	}
+
+	env, err := cel.NewEnv(options...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, nil, fmt.Errorf("invalid query: %w", issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType {
+		return nil, nil, fmt.Errorf("invalid query: expression has to return a bool, got %s", ast.OutputType())
+	}
+
+	return env, ast, nil
+}
@@ -0,0 +1,118 @@
+package remediation
+
+// BuiltIn remediations for the Kyverno Pod Security Standards policies
+var BuiltIn = []Remediation{
+	{
+		Policy:   "disallow-capabilities",
+		Guidance: "Only add capabilities of the baseline allow list to securityContext.capabilities.add of each container.",
+		Patch: `spec:
+  containers:
+  - name: <container>
+    securityContext:
+      capabilities:
+        add: []`,
+	},
+	{
+		Policy:   "disallow-capabilities-strict",
+		Guidance: "Drop ALL capabilities and only add NET_BIND_SERVICE if required in securityContext.capabilities of each container.",
+		Patch: `spec:
+  containers:
+  - name: <container>
+    securityContext:
+      capabilities:
+        drop:
+        - ALL`,
+	},
+	{
+		Policy:   "disallow-host-namespaces",
+		Guidance: "Remove hostNetwork, hostPID and hostIPC or set them to false.",
+		Patch: `spec:
+  hostNetwork: false
+  hostPID: false
+  hostIPC: false`,
+	},
+	{
+		Policy:   "disallow-host-path",
+		Guidance: "Replace hostPath volumes with persistent volumes, configMaps, secrets or emptyDir volumes.",
+	},
+	{
+		Policy:   "disallow-host-ports",
+		Guidance: "Remove hostPort from the container ports or set it to 0, expose the workload via a Service instead.",
+	},
+	{
+		Policy:   "disallow-host-process",
+		Guidance: "Remove securityContext.windowsOptions.hostProcess or set it to false.",
+		Patch: `spec:
+  securityContext:
+    windowsOptions:
+      hostProcess: false`,
+	},
+	{
+		Policy:   "disallow-privileged-containers",
+		Guidance: "Remove securityContext.privileged or set it to false for each container.",
+		Patch: `spec:
+  containers:
+  - name: <container>
+    securityContext:
+      privileged: false`,
+	},
+	{
+		Policy:   "disallow-privilege-escalation",
+		Guidance: "Set securityContext.allowPrivilegeEscalation to false for each container.",
+		Patch: `spec:
+  containers:
+  - name: <container>
+    securityContext:
+      allowPrivilegeEscalation: false`,
+	},
+	{
+		Policy:   "disallow-proc-mount",
+		Guidance: "Remove securityContext.procMount or set it to Default for each container.",
+	},
+	{
+		Policy:   "disallow-selinux",
+		Guidance: "Only use the SELinux types container_t, container_init_t or container_kvm_t and do not set a SELinux user or role.",
+	},
+	{
+		Policy:   "restrict-apparmor-profiles",
+		Guidance: "Use the runtime/default or a localhost/* AppArmor profile, or remove the AppArmor annotation.",
+	},
+	{
+		Policy:   "restrict-seccomp",
+		Guidance: "Remove securityContext.seccompProfile or set its type to RuntimeDefault or Localhost.",
+		Patch: `spec:
+  securityContext:
+    seccompProfile:
+      type: RuntimeDefault`,
+	},
+	{
+		Policy:   "restrict-seccomp-strict",
+		Guidance: "Set securityContext.seccompProfile.type to RuntimeDefault or Localhost on the Pod or on each container.",
+		Patch: `spec:
+  securityContext:
+    seccompProfile:
+      type: RuntimeDefault`,
+	},
+	{
+		Policy:   "restrict-sysctls",
+		Guidance: "Only use the safe sysctls kernel.shm_rmid_forced, net.ipv4.ip_local_port_range, net.ipv4.ip_unprivileged_port_start, net.ipv4.tcp_syncookies and net.ipv4.ping_group_range.",
+	},
+	{
+		Policy:   "require-run-as-nonroot",
+		Guidance: "Set securityContext.runAsNonRoot to true on the Pod or on each container.",
+		Patch: `spec:
+  securityContext:
+    runAsNonRoot: true`,
+	},
+	{
+		Policy:   "require-run-as-non-root-user",
+		Guidance: "Set securityContext.runAsUser to a non zero user ID or remove it to use the user of the image.",
+		Patch: `spec:
+  securityContext:
+    runAsUser: 1000`,
+	},
+	{
+		Policy:   "restrict-volume-types",
+		Guidance: "Only use configMap, csi, downwardAPI, emptyDir, ephemeral, persistentVolumeClaim, projected and secret volumes.",
+	},
+}
@@ -0,0 +1,129 @@
+package remediation
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"sigs.k8s.io/yaml"
+)
+
+// Remediation describes how to fix the results of a policy or a single rule, an empty Rule applies to all rules of the policy
+type Remediation struct {
+	Policy   string `json:"policy"`
+	Rule     string `json:"rule,omitempty"`
+	Guidance string `json:"guidance"`
+	Patch    string `json:"patch,omitempty"`
+}
+
+// File is the format of the YAML file to extend the built-in remediations
+type File struct {
+	Remediations []Remediation `json:"remediations"`
+}
+
+// Catalogue of remediations by policy and rule
+type Catalogue struct {
+	entries map[string]Remediation
+}
+
+// Lookup returns the remediation of the rule, or of the policy if no rule specific remediation exists
+func (c *Catalogue) Lookup(policy, rule string) (Remediation, bool) {
+	if c == nil {
+		return Remediation{}, false
+	}
+
+	if entry, ok := c.entries[key(policy, rule)]; ok {
+		return entry, true
+	}
+
+	entry, ok := c.entries[key(policy, "")]
+
+	return entry, ok
+}
+
+// Add registers the remediations, existing entries for the same policy and rule are replaced
+func (c *Catalogue) Add(remediations ...Remediation) {
+	for _, remediation := range remediations {
+		c.entries[key(remediation.Policy, remediation.Rule)] = remediation
+	}
+}
+
+// Annotate sets the guidance of each result with a remediation
+func (c *Catalogue) Annotate(results []policyreporter.PolicyReportResult) {
+	for i, result := range results {
+		if entry, ok := c.Lookup(result.Policy, result.Rule); ok {
+			results[i].Remediation = entry.Guidance
+		}
+	}
+}
+
+// ForResults returns the unique remediations of all failing results, sorted by policy and rule
+func (c *Catalogue) ForResults(results []policyreporter.PolicyReportResult) []Remediation {
+	unique := make(map[string]Remediation)
+	for _, result := range results {
+		if result.Status == policyreporter.Pass || result.Status == policyreporter.Skip {
+			continue
+		}
+
+		if entry, ok := c.Lookup(result.Policy, result.Rule); ok {
+			unique[key(entry.Policy, entry.Rule)] = entry
+		}
+	}
+
+	list := make([]Remediation, 0, len(unique))
+	for _, entry := range unique {
+		list = append(list, entry)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return key(list[i].Policy, list[i].Rule) < key(list[j].Policy, list[j].Rule)
+	})
+
+	return list
+}
+
+// LoadFile adds the remediations of the YAML file
+func (c *Catalogue) LoadFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	file := File{}
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return fmt.Errorf("invalid remediation file %s: %w", path, err)
+	}
+
+	for _, entry := range file.Remediations {
+		if entry.Policy == "" {
+			return fmt.Errorf("invalid remediation file %s: policy is required", path)
+		}
+	}
+
+	c.Add(file.Remediations...)
+
+	return nil
+}
+
+// NewCatalogue creates a Catalogue with the built-in remediations
+func NewCatalogue() *Catalogue {
+	catalogue := &Catalogue{entries: make(map[string]Remediation)}
+	catalogue.Add(BuiltIn...)
+
+	return catalogue
+}
+
+// Load creates a Catalogue with the built-in remediations extended by the file, if configured
+func Load(file string) (*Catalogue, error) {
+	catalogue := NewCatalogue()
+	if file == "" {
+		return catalogue, nil
+	}
+
+	return catalogue, catalogue.LoadFile(file)
+}
+
+func key(policy, rule string) string {
+	return fmt.Sprintf("%s/%s", policy, rule)
+}
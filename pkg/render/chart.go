@@ -0,0 +1,65 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/ttacon/chalk"
+)
+
+// DefaultBarWidth is the width of the longest bar in characters
+const DefaultBarWidth = 50
+
+var statusColor = map[policyreporter.Result]chalk.Color{
+	policyreporter.Pass:  chalk.Green,
+	policyreporter.Fail:  chalk.Red,
+	policyreporter.Warn:  chalk.Yellow,
+	policyreporter.Error: chalk.Magenta,
+	policyreporter.Skip:  chalk.Cyan,
+}
+
+// BarChart renders each summary row as a stacked bar, colored by result status
+func BarChart(w io.Writer, s *summary.Summary, width int) {
+	if width <= 0 {
+		width = DefaultBarWidth
+	}
+
+	legend := make([]string, 0, len(policyreporter.AllResults))
+	for _, status := range policyreporter.AllResults {
+		legend = append(legend, statusColor[status].Color("█ "+status))
+	}
+
+	fmt.Fprintf(w, "%s\n\n", strings.Join(legend, "  "))
+
+	var maxTotal, labelWidth int
+	for _, row := range s.Rows {
+		if row.Total > maxTotal {
+			maxTotal = row.Total
+		}
+		if len(row.Name) > labelWidth {
+			labelWidth = len(row.Name)
+		}
+	}
+
+	for _, row := range s.Rows {
+		var bar strings.Builder
+		for _, status := range policyreporter.AllResults {
+			count := row.Count(status)
+			if count == 0 {
+				continue
+			}
+
+			length := count * width / maxTotal
+			if length == 0 {
+				length = 1
+			}
+
+			bar.WriteString(statusColor[status].Color(strings.Repeat("█", length)))
+		}
+
+		fmt.Fprintf(w, "%-*s %s %d\n", labelWidth, row.Name, bar.String(), row.Total)
+	}
+}
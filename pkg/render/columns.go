@@ -0,0 +1,77 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/config"
+	"github.com/thediveo/klo"
+)
+
+// ErrColumnsWithOutput is returned if custom columns or templates are combined with an output format other than wide
+var ErrColumnsWithOutput = errors.New("--columns and --template can only be combined with -o wide")
+
+// Columns is the output of a command resolved from the --columns and --template flags and its configured view
+type Columns struct {
+	Output   string
+	Spec     string
+	Template string
+}
+
+// ResolveColumns resolves columns as column preset of the view or custom column spec and template as go-template of the view.
+// The default preset of the view is used if neither columns, template nor output are set.
+func ResolveColumns(view config.View, output, columns, template string) (Columns, error) {
+	if (columns != "" || template != "") && output != "" && output != "wide" {
+		return Columns{}, ErrColumnsWithOutput
+	}
+
+	if template != "" {
+		value, ok := view.Templates[strings.ToLower(template)]
+		if !ok {
+			return Columns{}, fmt.Errorf("unknown template %q, available templates: %s", template, joinOrNone(names(view.Templates)))
+		}
+
+		return Columns{Output: "go-template", Template: value}, nil
+	}
+
+	if columns == "" && output == "" {
+		columns = view.Default
+	}
+
+	if columns == "" {
+		return Columns{Output: output}, nil
+	}
+
+	if preset, ok := view.Columns[strings.ToLower(columns)]; ok {
+		return Columns{Spec: preset}, nil
+	}
+
+	if !strings.Contains(columns, ":") {
+		return Columns{}, fmt.Errorf("unknown column preset %q, available presets: %s", columns, joinOrNone(names(view.Columns)))
+	}
+
+	return Columns{Spec: columns}, nil
+}
+
+// Specs returns the default and wide column specs of the command, both are replaced by a custom column spec
+func (c Columns) Specs(defaultSpec, wideSpec string) *klo.Specs {
+	if c.Spec != "" {
+		return &klo.Specs{DefaultColumnSpec: c.Spec, WideColumnSpec: c.Spec}
+	}
+
+	return &klo.Specs{DefaultColumnSpec: defaultSpec, WideColumnSpec: wideSpec, GoTemplateArg: c.Template}
+}
+
+// names returns the sorted keys of the presets or templates
+func names(values map[string]string) []string {
+	list := make([]string, 0, len(values))
+	for name := range values {
+		list = append(list, name)
+	}
+
+	sort.Strings(list)
+
+	return list
+}
@@ -0,0 +1,158 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/remediation"
+)
+
+// Relation of a RelatedResource to the described resource
+const (
+	OwnerRelation = "Owner"
+	ChildRelation = "Child"
+)
+
+// RelatedResource is an owner or child of the described resource with its results
+type RelatedResource struct {
+	Relation string
+	Kind     string
+	Name     string
+	Results  []policyreporter.PolicyReportResult
+}
+
+// Description of a single resource and its results
+type Description struct {
+	Kind       string
+	APIVersion string
+	Name       string
+	Namespace  string
+	Results    []policyreporter.PolicyReportResult
+	Related    []RelatedResource
+
+	Remediations []remediation.Remediation
+}
+
+// Describe renders the Description in a kubectl describe like layout
+func Describe(w io.Writer, d Description) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Name:\t%s\n", d.Name)
+	if d.Namespace != "" {
+		fmt.Fprintf(tw, "Namespace:\t%s\n", d.Namespace)
+	}
+	fmt.Fprintf(tw, "Kind:\t%s\n", d.Kind)
+	if d.APIVersion != "" {
+		fmt.Fprintf(tw, "API Version:\t%s\n", d.APIVersion)
+	}
+	fmt.Fprintf(tw, "Summary:\t%s\n", countStatus(d.Results))
+	fmt.Fprintln(tw, "Results:")
+
+	if len(d.Results) == 0 {
+		fmt.Fprintln(tw, "  <none>")
+	}
+
+	for _, result := range d.Results {
+		fmt.Fprintf(tw, "  Policy:\t%s\n", result.Policy)
+		fmt.Fprintf(tw, "  Rule:\t%s\n", result.Rule)
+		fmt.Fprintf(tw, "  Result:\t%s\n", result.Status)
+		fmt.Fprintf(tw, "  Severity:\t%s\n", valueOrNone(result.Severity))
+		fmt.Fprintf(tw, "  Category:\t%s\n", valueOrNone(result.Category))
+		fmt.Fprintf(tw, "  Source:\t%s\n", valueOrNone(result.Source))
+		fmt.Fprintf(tw, "  Timestamp:\t%s\n", valueOrNone(result.TimeFormatted))
+		fmt.Fprintf(tw, "  Message:\t%s\n", valueOrNone(result.Message))
+		if result.Remediation != "" {
+			fmt.Fprintf(tw, "  Remediation:\t%s\n", result.Remediation)
+		}
+
+		if len(result.Properties) > 0 {
+			fmt.Fprintln(tw, "  Properties:")
+
+			keys := make([]string, 0, len(result.Properties))
+			for key := range result.Properties {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				fmt.Fprintf(tw, "    %s:\t%s\n", key, result.Properties[key])
+			}
+		}
+
+		fmt.Fprintln(tw, "")
+	}
+
+	patches := make([]remediation.Remediation, 0, len(d.Remediations))
+	for _, entry := range d.Remediations {
+		if entry.Patch != "" {
+			patches = append(patches, entry)
+		}
+	}
+
+	if len(patches) > 0 {
+		fmt.Fprintln(tw, "Remediation Patches:")
+
+		for _, entry := range patches {
+			name := entry.Policy
+			if entry.Rule != "" {
+				name = fmt.Sprintf("%s/%s", entry.Policy, entry.Rule)
+			}
+
+			fmt.Fprintf(tw, "  %s:\n%s\n", name, indent(entry.Patch, "    "))
+		}
+
+		fmt.Fprintln(tw, "")
+	}
+
+	fmt.Fprintln(tw, "Related Resources:")
+
+	if len(d.Related) == 0 {
+		fmt.Fprintln(tw, "  <none>")
+	}
+
+	for _, related := range d.Related {
+		fmt.Fprintf(tw, "  %s %s/%s:\t%s\n", related.Relation, related.Kind, related.Name, countStatus(related.Results))
+
+		for _, result := range related.Results {
+			if result.Status == policyreporter.Pass || result.Status == policyreporter.Skip {
+				continue
+			}
+
+			fmt.Fprintf(tw, "    %s/%s:\t%s\t%s\n", result.Policy, result.Rule, result.Status, result.Message)
+		}
+	}
+
+	return tw.Flush()
+}
+
+func countStatus(results []policyreporter.PolicyReportResult) string {
+	counts := make(map[string]int, len(policyreporter.AllResults))
+	for _, result := range results {
+		counts[result.Status]++
+	}
+
+	parts := make([]string, 0, len(policyreporter.AllResults))
+	for _, status := range policyreporter.AllResults {
+		if counts[status] > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", status, counts[status]))
+		}
+	}
+
+	if len(parts) == 0 {
+		return "<none>"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+
+	return value
+}
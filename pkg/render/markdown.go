@@ -0,0 +1,245 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/model"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/kyverno/policy-reporter-cli/pkg/remediation"
+)
+
+// DefaultMarkdownMaxSize stays below the 65536 characters limit of GitHub comments
+const DefaultMarkdownMaxSize = 65000
+
+var statusEmoji = map[policyreporter.Result]string{
+	policyreporter.Pass:  ":white_check_mark:",
+	policyreporter.Fail:  ":x:",
+	policyreporter.Warn:  ":warning:",
+	policyreporter.Error: ":boom:",
+	policyreporter.Skip:  ":fast_forward:",
+}
+
+type MarkdownOptions struct {
+	Title      string // Title of the summary header
+	Namespaced bool   // Namespaced adds the namespace column
+	Details    bool   // Details collapses each group into a <details> block
+	MaxSize    int    // MaxSize is the maximal output size in bytes, 0 disables the limit
+
+	Remediations []remediation.Remediation // Remediations are listed after the results
+}
+
+// Markdown renders grouped results as GitHub-flavored markdown tables
+func Markdown(w io.Writer, groups []*model.Group, options MarkdownOptions) error {
+	builder := &markdownBuilder{options: options, total: countResults(groups)}
+
+	builder.header(groups)
+
+	for _, group := range groups {
+		if !builder.group(group, len(groups) > 1) {
+			break
+		}
+	}
+
+	if builder.truncated > 0 {
+		builder.WriteString(fmt.Sprintf("\n> :warning: Output truncated, %d of %d results are not shown\n", builder.truncated, builder.total))
+	} else {
+		builder.remediations()
+	}
+
+	_, err := io.WriteString(w, builder.String())
+
+	return err
+}
+
+// markdownTruncationReserve keeps space for closing tags and the truncation note
+const markdownTruncationReserve = 200
+
+type markdownBuilder struct {
+	strings.Builder
+	options   MarkdownOptions
+	total     int
+	written   int
+	truncated int
+}
+
+func (b *markdownBuilder) fits(content string) bool {
+	if b.options.MaxSize <= 0 {
+		return true
+	}
+
+	return b.Len()+len(content)+markdownTruncationReserve <= b.options.MaxSize
+}
+
+func (b *markdownBuilder) header(groups []*model.Group) {
+	title := b.options.Title
+	if title == "" {
+		title = "Policy Reporter Results"
+	}
+
+	b.WriteString(fmt.Sprintf("### %s\n\n", title))
+
+	if b.total == 0 {
+		b.WriteString("No results found\n")
+		return
+	}
+
+	counts := make(map[string]int, len(policyreporter.AllResults))
+	for _, group := range groups {
+		for _, result := range group.List {
+			counts[result.Status]++
+		}
+	}
+
+	summary := make([]string, 0, len(policyreporter.AllResults))
+	for _, status := range policyreporter.AllResults {
+		if counts[status] == 0 {
+			continue
+		}
+
+		summary = append(summary, fmt.Sprintf("%s %d %s", statusEmoji[status], counts[status], status))
+	}
+
+	b.WriteString(fmt.Sprintf("**%d results**: %s\n", b.total, strings.Join(summary, " · ")))
+}
+
+// group writes a single group table and returns false if the size limit was reached
+func (b *markdownBuilder) group(group *model.Group, withLabel bool) bool {
+	if len(group.List) == 0 {
+		return true
+	}
+
+	var open, close string
+
+	var label string
+	if withLabel {
+		label = group.Label
+	}
+
+	if b.options.Details {
+		summary := label
+		if summary == "" {
+			summary = "Results"
+		}
+		open = fmt.Sprintf("\n<details>\n<summary><b>%s</b> (%d)</summary>\n\n", escapeMarkdown(summary), len(group.List))
+		close = "\n</details>\n"
+	} else if label != "" {
+		open = fmt.Sprintf("\n#### %s (%d)\n\n", escapeMarkdown(label), len(group.List))
+	} else {
+		open = "\n"
+	}
+
+	head := b.tableHeader()
+	if !b.fits(open + head + close) {
+		b.truncated = b.total - b.written
+		return false
+	}
+
+	b.WriteString(open)
+	b.WriteString(head)
+
+	for i, result := range group.List {
+		row := b.tableRow(result)
+		if !b.fits(row + close) {
+			b.truncated = b.total - b.written
+			b.WriteString(fmt.Sprintf("| … %d more |%s\n", len(group.List)-i, strings.Repeat(" |", len(b.columns())-1)))
+			b.WriteString(close)
+			return false
+		}
+
+		b.WriteString(row)
+		b.written++
+	}
+
+	b.WriteString(close)
+
+	return true
+}
+
+// remediations writes the remediation section, remediations which exceed the size limit are skipped
+func (b *markdownBuilder) remediations() {
+	if len(b.options.Remediations) == 0 {
+		return
+	}
+
+	head := "\n#### Remediation\n\n"
+	if !b.fits(head) {
+		return
+	}
+
+	b.WriteString(head)
+
+	for _, entry := range b.options.Remediations {
+		name := entry.Policy
+		if entry.Rule != "" {
+			name = fmt.Sprintf("%s/%s", entry.Policy, entry.Rule)
+		}
+
+		content := fmt.Sprintf("- **%s**: %s\n", escapeMarkdown(name), escapeMarkdown(entry.Guidance))
+		if entry.Patch != "" {
+			content += fmt.Sprintf("  ```yaml\n%s\n  ```\n", indent(entry.Patch, "  "))
+		}
+
+		if !b.fits(content) {
+			return
+		}
+
+		b.WriteString(content)
+	}
+}
+
+func indent(value, prefix string) string {
+	lines := strings.Split(strings.TrimRight(value, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = prefix + line
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (b *markdownBuilder) columns() []string {
+	columns := []string{"Result"}
+	if b.options.Namespaced {
+		columns = append(columns, "Namespace")
+	}
+
+	return append(columns, "Kind", "Name", "Policy", "Rule", "Severity")
+}
+
+func (b *markdownBuilder) tableHeader() string {
+	columns := b.columns()
+
+	return fmt.Sprintf("| %s |\n|%s\n", strings.Join(columns, " | "), strings.Repeat(" --- |", len(columns)))
+}
+
+func (b *markdownBuilder) tableRow(result policyreporter.PolicyReportResult) string {
+	cells := []string{fmt.Sprintf("%s %s", statusEmoji[result.Status], result.Status)}
+	if b.options.Namespaced {
+		cells = append(cells, result.Namespace)
+	}
+
+	cells = append(cells, result.Kind, result.Name, result.Policy, result.Rule, result.Severity)
+	for i, cell := range cells {
+		cells[i] = escapeMarkdown(cell)
+	}
+
+	return fmt.Sprintf("| %s |\n", strings.Join(cells, " | "))
+}
+
+func countResults(groups []*model.Group) int {
+	var count int
+	for _, group := range groups {
+		count += len(group.List)
+	}
+
+	return count
+}
+
+func escapeMarkdown(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	value = strings.ReplaceAll(value, "<", "&lt;")
+	value = strings.ReplaceAll(value, ">", "&gt;")
+
+	return strings.ReplaceAll(value, "\n", " ")
+}
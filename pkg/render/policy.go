@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/thediveo/klo"
+)
+
+// Policies renders the policy list as table or any other output supported by klo
+func Policies(w io.Writer, policies []summary.Policy, output string) error {
+	if len(policies) == 0 {
+		fmt.Fprintln(w, "No policies found")
+		return nil
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+		DefaultColumnSpec: "POLICY:{.Name},PASS:{.Pass},FAIL:{.Fail},WARN:{.Warn},ERROR:{.Error},SKIP:{.Skip},CATEGORIES:{.Categories[*]}",
+		WideColumnSpec:    "POLICY:{.Name},PASS:{.Pass},FAIL:{.Fail},WARN:{.Warn},ERROR:{.Error},SKIP:{.Skip},CATEGORIES:{.Categories[*]},SEVERITIES:{.Severities[*]},SOURCES:{.Sources[*]}",
+	})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(w, policies)
+}
+
+// PolicyDetails renders the affected rules and resources of a policy in a kubectl describe like layout,
+// json and yaml output prints the complete details
+func PolicyDetails(w io.Writer, details *summary.PolicyDetails, output string) error {
+	if output == "json" || output == "yaml" {
+		prn, err := klo.PrinterFromFlag(output, nil)
+		if err != nil {
+			return err
+		}
+
+		return prn.Fprint(w, details)
+	}
+
+	policy := details.Policy
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Name:\t%s\n", policy.Name)
+	fmt.Fprintf(tw, "Categories:\t%s\n", joinOrNone(policy.Categories))
+	fmt.Fprintf(tw, "Severities:\t%s\n", joinOrNone(policy.Severities))
+	fmt.Fprintf(tw, "Sources:\t%s\n", joinOrNone(policy.Sources))
+	fmt.Fprintf(tw, "Summary:\tpass: %d, fail: %d, warn: %d, error: %d, skip: %d\n", policy.Pass, policy.Fail, policy.Warn, policy.Error, policy.Skip)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, section := range []struct {
+		label string
+		rows  []summary.Row
+	}{
+		{"RULE", details.Rules},
+		{"RESOURCE", details.Resources},
+	} {
+		fmt.Fprintln(w, "")
+
+		prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+			DefaultColumnSpec: fmt.Sprintf("%s:{.Name},PASS:{.Pass},FAIL:{.Fail},WARN:{.Warn},ERROR:{.Error},SKIP:{.Skip}", section.label),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := prn.Fprint(w, section.rows); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func joinOrNone(values []string) string {
+	if len(values) == 0 {
+		return "<none>"
+	}
+
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,44 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/thediveo/klo"
+)
+
+// Scores renders the pass rates as table or any other output supported by klo
+func Scores(w io.Writer, scores []summary.Score, dimension summary.Dimension, output string) error {
+	if len(scores) == 0 {
+		fmt.Fprintln(w, "No results found")
+		return nil
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+		DefaultColumnSpec: fmt.Sprintf("%s:{.Name},SCORE:{.Score},PASS:{.Pass},FAIL:{.Fail},WARN:{.Warn},ERROR:{.Error}", strings.ToUpper(dimension)),
+	})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(w, scores)
+}
+
+// TopOffenders renders the ranking as table or any other output supported by klo
+func TopOffenders(w io.Writer, offenders []summary.Offender, dimension summary.Dimension, output string) error {
+	if len(offenders) == 0 {
+		fmt.Fprintln(w, "No failing results found")
+		return nil
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+		DefaultColumnSpec: fmt.Sprintf("RANK:{.Rank},%s:{.Name},FAIL:{.Fail},WARN:{.Warn},ERROR:{.Error},WEIGHT:{.Weight},SHARE %%:{.Share}", strings.ToUpper(dimension)),
+	})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(w, offenders)
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/summary"
+	"github.com/thediveo/klo"
+)
+
+// BarOutput renders a Summary as bar chart
+const BarOutput = "bar"
+
+// Summary renders the count matrix as table, bar chart or any other output supported by klo
+func Summary(w io.Writer, s *summary.Summary, output string, barWidth int) error {
+	if len(s.Rows) == 0 {
+		fmt.Fprintln(w, "No results found")
+		return nil
+	}
+
+	switch output {
+	case BarOutput:
+		BarChart(w, s, barWidth)
+		return nil
+	case "json", "yaml":
+		prn, err := klo.PrinterFromFlag(output, nil)
+		if err != nil {
+			return err
+		}
+
+		return prn.Fprint(w, s)
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+		DefaultColumnSpec: fmt.Sprintf("%s:{.Name},PASS:{.Pass},FAIL:{.Fail},WARN:{.Warn},ERROR:{.Error},SKIP:{.Skip},TOTAL:{.Total}", strings.ToUpper(s.Dimension)),
+	})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(w, append(s.Rows, s.Total))
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/vulns"
+	"github.com/thediveo/klo"
+)
+
+// Vulnerabilities renders each vulnerability of each resource as table or any other output supported by klo
+func Vulnerabilities(w io.Writer, list []vulns.Vulnerability, output string) error {
+	if len(list) == 0 {
+		fmt.Fprintln(w, "No vulnerabilities found")
+		return nil
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+		DefaultColumnSpec: "NAMESPACE:{.Namespace},KIND:{.Kind},NAME:{.Name},ID:{.ID},SEVERITY:{.Severity},PACKAGE:{.Package},INSTALLED:{.InstalledVersion},FIXED:{.FixedVersion}",
+		WideColumnSpec:    "NAMESPACE:{.Namespace},KIND:{.Kind},NAME:{.Name},IMAGE:{.Image},ID:{.ID},SEVERITY:{.Severity},SCORE:{.Score},PACKAGE:{.Package},INSTALLED:{.InstalledVersion},FIXED:{.FixedVersion},TITLE:{.Title}",
+	})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(w, list)
+}
+
+// VulnerableImages renders the vulnerability counts per image as table or any other output supported by klo
+func VulnerableImages(w io.Writer, list []vulns.Image, output string) error {
+	if len(list) == 0 {
+		fmt.Fprintln(w, "No vulnerabilities found")
+		return nil
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+		DefaultColumnSpec: "IMAGE:{.Image},CRITICAL:{.Critical},HIGH:{.High},MEDIUM:{.Medium},LOW:{.Low},TOTAL:{.Total},FIXABLE:{.Fixable}",
+		WideColumnSpec:    "IMAGE:{.Image},CRITICAL:{.Critical},HIGH:{.High},MEDIUM:{.Medium},LOW:{.Low},OTHER:{.Other},TOTAL:{.Total},FIXABLE:{.Fixable},MAX SCORE:{.Score},RESOURCES:{.Resources}",
+	})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(w, list)
+}
+
+// CVEs renders the aggregated vulnerabilities as table or any other output supported by klo
+func CVEs(w io.Writer, list []vulns.CVE, output string) error {
+	if len(list) == 0 {
+		fmt.Fprintln(w, "No vulnerabilities found")
+		return nil
+	}
+
+	prn, err := klo.PrinterFromFlag(output, &klo.Specs{
+		DefaultColumnSpec: "ID:{.ID},SEVERITY:{.Severity},SCORE:{.Score},PACKAGES:{.Packages[*]},FIXED:{.FixedVersions[*]},IMAGES:{.Images},RESOURCES:{.Resources}",
+		WideColumnSpec:    "ID:{.ID},SEVERITY:{.Severity},SCORE:{.Score},PACKAGES:{.Packages[*]},FIXED:{.FixedVersions[*]},IMAGES:{.Images},RESOURCES:{.Resources},TITLE:{.Title}",
+	})
+	if err != nil {
+		return err
+	}
+
+	return prn.Fprint(w, list)
+}
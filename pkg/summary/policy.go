@@ -0,0 +1,113 @@
+package summary
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// ErrPolicyNotFound is returned if no results of the described policy exist
+var ErrPolicyNotFound = errors.New("policy not found")
+
+// Policy contains the result counts, categories, severities and sources of a single policy
+type Policy struct {
+	Name       string   `json:"name"`
+	Categories []string `json:"categories"`
+	Severities []string `json:"severities"`
+	Sources    []string `json:"sources"`
+	Pass       int      `json:"pass"`
+	Fail       int      `json:"fail"`
+	Warn       int      `json:"warn"`
+	Error      int      `json:"error"`
+	Skip       int      `json:"skip"`
+	Total      int      `json:"total"`
+}
+
+// PolicyDetails contains the results of a single policy per rule and per affected resource
+type PolicyDetails struct {
+	Policy    Policy `json:"policy"`
+	Rules     []Row  `json:"rules"`
+	Resources []Row  `json:"resources"`
+}
+
+// Policies aggregates the results per policy, policies are sorted by name
+func Policies(results []policyreporter.PolicyReportResult) []Policy {
+	rows, _ := summarizeBy(results, Dimensions[PolicyDimension])
+
+	policies := make([]Policy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, newPolicy(row, results))
+	}
+
+	return policies
+}
+
+// DescribePolicy aggregates the results of the policy per rule and per resource
+func DescribePolicy(results []policyreporter.PolicyReportResult, name string) (*PolicyDetails, error) {
+	filtered := make([]policyreporter.PolicyReportResult, 0)
+	for _, result := range results {
+		if result.Policy == name {
+			filtered = append(filtered, result)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return nil, ErrPolicyNotFound
+	}
+
+	_, total := summarizeBy(filtered, Dimensions[PolicyDimension])
+	total.Name = name
+
+	rules, _ := summarizeBy(filtered, func(r policyreporter.PolicyReportResult) string { return r.Rule })
+	resources, _ := summarizeBy(filtered, resourceName)
+
+	return &PolicyDetails{
+		Policy:    newPolicy(total, filtered),
+		Rules:     rules,
+		Resources: resources,
+	}, nil
+}
+
+func newPolicy(row Row, results []policyreporter.PolicyReportResult) Policy {
+	categories := make(map[string]bool)
+	severities := make(map[string]bool)
+	sources := make(map[string]bool)
+
+	for _, result := range results {
+		if result.Policy != row.Name {
+			continue
+		}
+
+		categories[result.Category] = true
+		severities[result.Severity] = true
+		sources[result.Source] = true
+	}
+
+	return Policy{
+		Name:       row.Name,
+		Categories: keys(categories),
+		Severities: keys(severities),
+		Sources:    keys(sources),
+		Pass:       row.Pass,
+		Fail:       row.Fail,
+		Warn:       row.Warn,
+		Error:      row.Error,
+		Skip:       row.Skip,
+		Total:      row.Total,
+	}
+}
+
+// keys returns the sorted, non empty keys of the set
+func keys(set map[string]bool) []string {
+	list := make([]string, 0, len(set))
+	for key := range set {
+		if key != "" {
+			list = append(list, key)
+		}
+	}
+
+	sort.Strings(list)
+
+	return list
+}
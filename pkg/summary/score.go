@@ -0,0 +1,185 @@
+package summary
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// ClusterDimension aggregates all results into a single score
+const ClusterDimension Dimension = "cluster"
+
+// ErrMinScore is returned if at least one score is lower than the configured minimum
+var ErrMinScore = errors.New("score below minimum")
+
+// Sortings of calculated Scores
+const (
+	SortByScore = "score"
+	SortByName  = "name"
+)
+
+// Weights per severity used to calculate a weighted Score, unknown severities have a weight of 1
+type Weights map[policyreporter.Severity]float64
+
+// Weight returns the weight for the given severity
+func (w Weights) Weight(severity policyreporter.Severity) float64 {
+	if weight, ok := w[severity]; ok {
+		return weight
+	}
+
+	return 1
+}
+
+// DefaultWeights are used for weighted Scores without configured weights
+var DefaultWeights = Weights{
+	policyreporter.High:   3,
+	policyreporter.Medium: 2,
+	policyreporter.Low:    1,
+}
+
+// ParseWeights parses severity=weight pairs as configured via flags
+func ParseWeights(values map[string]string) (Weights, error) {
+	weights := make(Weights, len(values))
+
+	for severity, value := range values {
+		var weight float64
+		if _, err := fmt.Sscanf(value, "%g", &weight); err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight %q for severity %q", value, severity)
+		}
+
+		weights[strings.ToLower(severity)] = weight
+	}
+
+	return weights, nil
+}
+
+// ResolveWeights merges the DefaultWeights with the configured weights and the weights from flags, in this order
+func ResolveWeights(configured map[string]float64, flags map[string]string) (Weights, error) {
+	weights := make(Weights, len(DefaultWeights))
+	for severity, weight := range DefaultWeights {
+		weights[severity] = weight
+	}
+	for severity, weight := range configured {
+		weights[strings.ToLower(severity)] = weight
+	}
+
+	parsed, err := ParseWeights(flags)
+	if err != nil {
+		return nil, err
+	}
+	for severity, weight := range parsed {
+		weights[severity] = weight
+	}
+
+	return weights, nil
+}
+
+// Score is the pass rate of a single value of the scored Dimension in percent
+type Score struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+	Pass  int     `json:"pass"`
+	Fail  int     `json:"fail"`
+	Warn  int     `json:"warn"`
+	Error int     `json:"error"`
+}
+
+// Scores calculates the pass rate pass/(pass+fail+warn+error) per value of the given Dimension.
+// Each result counts with the weight of its severity if weights are given, skipped results are ignored.
+// Values without any scorable result are not part of the returned list.
+func Scores(results []policyreporter.PolicyReportResult, dimension Dimension, weights Weights) ([]Score, error) {
+	value, ok := Dimensions[dimension]
+	if dimension == ClusterDimension {
+		value, ok = func(policyreporter.PolicyReportResult) string { return ClusterDimension }, true
+	}
+	if !ok {
+		return nil, fmt.Errorf("unsupported dimension %q, expected one of: %s", dimension, strings.Join(append(SupportedDimensions(), ClusterDimension), ", "))
+	}
+
+	type counter struct {
+		Score
+		passed float64
+		total  float64
+	}
+
+	counters := make(map[string]*counter)
+
+	for _, result := range results {
+		if result.Status == policyreporter.Skip {
+			continue
+		}
+
+		name := value(result)
+		if name == "" {
+			name = "<none>"
+		}
+
+		c, ok := counters[name]
+		if !ok {
+			c = &counter{Score: Score{Name: name}}
+			counters[name] = c
+		}
+
+		weight := 1.0
+		if weights != nil {
+			weight = weights.Weight(result.Severity)
+		}
+
+		switch result.Status {
+		case policyreporter.Pass:
+			c.Pass++
+			c.passed += weight
+		case policyreporter.Fail:
+			c.Fail++
+		case policyreporter.Warn:
+			c.Warn++
+		case policyreporter.Error:
+			c.Error++
+		default:
+			continue
+		}
+
+		c.total += weight
+	}
+
+	scores := make([]Score, 0, len(counters))
+	for _, c := range counters {
+		if c.total == 0 {
+			continue
+		}
+
+		c.Score.Score = math.Round(c.passed/c.total*10000) / 100
+		scores = append(scores, c.Score)
+	}
+
+	SortScores(scores, SortByName)
+
+	return scores, nil
+}
+
+// SortScores sorts by name or ascending by score, so the lowest scores come first
+func SortScores(scores []Score, sorting string) {
+	sort.SliceStable(scores, func(i, j int) bool {
+		if sorting == SortByScore && scores[i].Score != scores[j].Score {
+			return scores[i].Score < scores[j].Score
+		}
+
+		return scores[i].Name < scores[j].Name
+	})
+}
+
+// BelowMinimum returns all scores lower than min
+func BelowMinimum(scores []Score, min float64) []Score {
+	list := make([]Score, 0)
+	for _, score := range scores {
+		if score.Score < min {
+			list = append(list, score)
+		}
+	}
+
+	return list
+}
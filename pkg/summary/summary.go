@@ -0,0 +1,153 @@
+package summary
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// Dimension the results are aggregated by
+type Dimension = string
+
+const (
+	NamespaceDimension Dimension = "namespace"
+	PolicyDimension    Dimension = "policy"
+	CategoryDimension  Dimension = "category"
+	SeverityDimension  Dimension = "severity"
+	SourceDimension    Dimension = "source"
+	KindDimension      Dimension = "kind"
+	ResourceDimension  Dimension = "resource"
+	RuleDimension      Dimension = "rule"
+)
+
+// Dimensions maps each supported Dimension to the result value it aggregates by
+var Dimensions = map[Dimension]func(policyreporter.PolicyReportResult) string{
+	NamespaceDimension: func(r policyreporter.PolicyReportResult) string { return r.Namespace },
+	PolicyDimension:    func(r policyreporter.PolicyReportResult) string { return r.Policy },
+	CategoryDimension:  func(r policyreporter.PolicyReportResult) string { return r.Category },
+	SeverityDimension:  func(r policyreporter.PolicyReportResult) string { return r.Severity },
+	SourceDimension:    func(r policyreporter.PolicyReportResult) string { return r.Source },
+	KindDimension:      func(r policyreporter.PolicyReportResult) string { return r.Kind },
+	ResourceDimension:  resourceName,
+	RuleDimension:      func(r policyreporter.PolicyReportResult) string { return fmt.Sprintf("%s/%s", r.Policy, r.Rule) },
+}
+
+func resourceName(r policyreporter.PolicyReportResult) string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s/%s", r.Kind, r.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", r.Namespace, r.Kind, r.Name)
+}
+
+// Row contains the result counts of a single value of the aggregated Dimension
+type Row struct {
+	Name  string `json:"name"`
+	Pass  int    `json:"pass"`
+	Fail  int    `json:"fail"`
+	Warn  int    `json:"warn"`
+	Error int    `json:"error"`
+	Skip  int    `json:"skip"`
+	Total int    `json:"total"`
+}
+
+// Count returns the count of the given result status
+func (r Row) Count(status policyreporter.Result) int {
+	switch status {
+	case policyreporter.Pass:
+		return r.Pass
+	case policyreporter.Fail:
+		return r.Fail
+	case policyreporter.Warn:
+		return r.Warn
+	case policyreporter.Error:
+		return r.Error
+	case policyreporter.Skip:
+		return r.Skip
+	}
+
+	return 0
+}
+
+func (r *Row) add(status policyreporter.Result) {
+	switch status {
+	case policyreporter.Pass:
+		r.Pass++
+	case policyreporter.Fail:
+		r.Fail++
+	case policyreporter.Warn:
+		r.Warn++
+	case policyreporter.Error:
+		r.Error++
+	case policyreporter.Skip:
+		r.Skip++
+	}
+
+	r.Total++
+}
+
+// Summary is the count matrix of result status × Dimension
+type Summary struct {
+	Dimension Dimension `json:"dimension"`
+	Rows      []Row     `json:"rows"`
+	Total     Row       `json:"total"`
+}
+
+// Summarize aggregates the results by the given Dimension, rows are sorted by name
+func Summarize(results []policyreporter.PolicyReportResult, dimension Dimension) (*Summary, error) {
+	value, ok := Dimensions[dimension]
+	if !ok {
+		return nil, fmt.Errorf("unsupported dimension %q, expected one of: %s", dimension, strings.Join(SupportedDimensions(), ", "))
+	}
+
+	rows, total := summarizeBy(results, value)
+
+	return &Summary{Dimension: dimension, Rows: rows, Total: total}, nil
+}
+
+// summarizeBy counts the results per value, rows are sorted by name
+func summarizeBy(results []policyreporter.PolicyReportResult, value func(policyreporter.PolicyReportResult) string) ([]Row, Row) {
+	rows := make(map[string]*Row)
+	total := Row{Name: "Total"}
+
+	for _, result := range results {
+		name := value(result)
+		if name == "" {
+			name = "<none>"
+		}
+
+		row, ok := rows[name]
+		if !ok {
+			row = &Row{Name: name}
+			rows[name] = row
+		}
+
+		row.add(result.Status)
+		total.add(result.Status)
+	}
+
+	list := make([]Row, 0, len(rows))
+	for _, row := range rows {
+		list = append(list, *row)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+
+	return list, total
+}
+
+// SupportedDimensions returns the sorted names of all Dimensions
+func SupportedDimensions() []string {
+	list := make([]string, 0, len(Dimensions))
+	for dimension := range Dimensions {
+		list = append(list, dimension)
+	}
+
+	sort.Strings(list)
+
+	return list
+}
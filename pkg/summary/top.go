@@ -0,0 +1,96 @@
+package summary
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// OffenderResults are the result status counted by TopOffenders if no status filter is used
+var OffenderResults = []policyreporter.Result{policyreporter.Fail, policyreporter.Warn, policyreporter.Error}
+
+// Offender is a single value of the ranked Dimension with its failing results
+type Offender struct {
+	Rank   int     `json:"rank"`
+	Name   string  `json:"name"`
+	Fail   int     `json:"fail"`
+	Warn   int     `json:"warn"`
+	Error  int     `json:"error"`
+	Weight float64 `json:"weight"`
+	Share  float64 `json:"share"`
+}
+
+// TopOffenders ranks the values of the given Dimension by their number of failing, warning and error results.
+// Each result counts with the weight of its severity if weights are given.
+// Share is the percentage of the total weight of all counted results, limit 0 returns all offenders.
+func TopOffenders(results []policyreporter.PolicyReportResult, dimension Dimension, weights Weights, limit int) ([]Offender, error) {
+	value, ok := Dimensions[dimension]
+	if !ok {
+		return nil, fmt.Errorf("unsupported dimension %q, expected one of: %s", dimension, strings.Join(SupportedDimensions(), ", "))
+	}
+
+	offenders := make(map[string]*Offender)
+
+	var total float64
+
+	for _, result := range results {
+		weight := 1.0
+		if weights != nil {
+			weight = weights.Weight(result.Severity)
+		}
+
+		name := value(result)
+		if name == "" {
+			name = "<none>"
+		}
+
+		offender, ok := offenders[name]
+		if !ok {
+			offender = &Offender{Name: name}
+		}
+
+		switch result.Status {
+		case policyreporter.Fail:
+			offender.Fail++
+		case policyreporter.Warn:
+			offender.Warn++
+		case policyreporter.Error:
+			offender.Error++
+		default:
+			continue
+		}
+
+		offenders[name] = offender
+		offender.Weight += weight
+		total += weight
+	}
+
+	list := make([]Offender, 0, len(offenders))
+	for _, offender := range offenders {
+		if total > 0 {
+			offender.Share = math.Round(offender.Weight/total*10000) / 100
+		}
+		list = append(list, *offender)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Weight != list[j].Weight {
+			return list[i].Weight > list[j].Weight
+		}
+
+		return list[i].Name < list[j].Name
+	})
+
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+
+	for i := range list {
+		list[i].Rank = i + 1
+	}
+
+	return list, nil
+}
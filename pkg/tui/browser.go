@@ -0,0 +1,490 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/rivo/tview"
+	"github.com/thediveo/klo"
+)
+
+// LoadFunc fetches all namespaced or cluster scoped results
+type LoadFunc = func(ctx context.Context, cluster bool) (policyreporter.ResultList, error)
+
+const allEntries = "<all>"
+
+const help = "[yellow]Tab[-] switch pane  [yellow]Enter[-] drill down  [yellow]Esc[-] back  [yellow]/[-] filter  [yellow]c[-] toggle cluster/namespaced  [yellow]r[-] reload  [yellow]e[-] export JSON  [yellow]y[-] export YAML  [yellow]q[-] quit"
+
+var statusColors = map[policyreporter.Result]string{
+	policyreporter.Pass:  "green",
+	policyreporter.Fail:  "red",
+	policyreporter.Warn:  "yellow",
+	policyreporter.Error: "fuchsia",
+	policyreporter.Skip:  "aqua",
+}
+
+// Browser is a full screen, interactive browser for (Cluster)PolicyReportResults
+type Browser struct {
+	ctx     context.Context
+	load    LoadFunc
+	cluster bool
+	// pending is the scope of the last requested load, cluster is only switched once its results are loaded
+	pending bool
+
+	results []policyreporter.PolicyReportResult
+	query   string
+
+	scope    string
+	resource string
+	view     []policyreporter.PolicyReportResult
+
+	scopeKeys    []string
+	resourceKeys []string
+	rebuilding   bool
+
+	app       *tview.Application
+	scopes    *tview.List
+	resources *tview.List
+	table     *tview.Table
+	details   *tview.TextView
+	filter    *tview.InputField
+	status    *tview.TextView
+	panes     []tview.Primitive
+}
+
+// Run loads the initial results and blocks until the user quits the browser
+func (b *Browser) Run() error {
+	b.layout()
+
+	if err := b.reload(); err != nil {
+		return err
+	}
+
+	return b.app.Run()
+}
+
+func (b *Browser) layout() {
+	b.scopes = tview.NewList().ShowSecondaryText(false).SetHighlightFullLine(true)
+	b.scopes.SetBorder(true)
+	b.scopes.SetChangedFunc(func(index int, _, _ string, _ rune) {
+		if b.rebuilding || index >= len(b.scopeKeys) {
+			return
+		}
+
+		b.scope = b.scopeKeys[index]
+		b.resource = allEntries
+		b.renderResources()
+	})
+	b.scopes.SetSelectedFunc(func(int, string, string, rune) { b.app.SetFocus(b.resources) })
+
+	b.resources = tview.NewList().ShowSecondaryText(false).SetHighlightFullLine(true)
+	b.resources.SetBorder(true).SetTitle(" Resources ")
+	b.resources.SetChangedFunc(func(index int, _, _ string, _ rune) {
+		if b.rebuilding || index >= len(b.resourceKeys) {
+			return
+		}
+
+		b.resource = b.resourceKeys[index]
+		b.renderResults()
+	})
+	b.resources.SetSelectedFunc(func(int, string, string, rune) { b.app.SetFocus(b.table) })
+
+	b.table = tview.NewTable().SetSelectable(true, false).SetFixed(1, 0)
+	b.table.SetBorder(true).SetTitle(" Results ")
+	b.table.SetSelectionChangedFunc(func(row, _ int) { b.renderDetails(row - 1) })
+	b.table.SetSelectedFunc(func(int, int) { b.app.SetFocus(b.details) })
+
+	b.details = tview.NewTextView().SetDynamicColors(true).SetWrap(true)
+	b.details.SetBorder(true).SetTitle(" Details ")
+
+	b.filter = tview.NewInputField().SetLabel("Filter: ").SetFieldBackgroundColor(tcell.ColorDefault)
+	b.filter.SetChangedFunc(func(text string) {
+		b.query = strings.ToLower(strings.TrimSpace(text))
+		b.renderScopes()
+	})
+	b.filter.SetDoneFunc(func(tcell.Key) { b.app.SetFocus(b.scopes) })
+
+	b.status = tview.NewTextView().SetDynamicColors(true).SetText(help)
+
+	b.panes = []tview.Primitive{b.scopes, b.resources, b.table, b.details}
+
+	columns := tview.NewFlex().
+		AddItem(b.scopes, 0, 1, true).
+		AddItem(b.resources, 0, 2, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(b.table, 0, 3, false).
+			AddItem(b.details, 0, 2, false), 0, 5, false)
+
+	root := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(b.filter, 1, 0, false).
+		AddItem(columns, 0, 1, true).
+		AddItem(b.status, 1, 0, false)
+
+	b.app = tview.NewApplication().SetRoot(root, true).SetFocus(b.scopes)
+	b.app.SetInputCapture(b.handleKey)
+}
+
+func (b *Browser) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	if b.app.GetFocus() == b.filter {
+		return event
+	}
+
+	switch event.Key() {
+	case tcell.KeyTab:
+		b.focus(1)
+		return nil
+	case tcell.KeyBacktab:
+		b.focus(-1)
+		return nil
+	case tcell.KeyEscape:
+		if b.app.GetFocus() != b.scopes {
+			b.focus(-1)
+		}
+		return nil
+	}
+
+	switch event.Rune() {
+	case 'q':
+		b.app.Stop()
+	case '/':
+		b.app.SetFocus(b.filter)
+	case 'c':
+		b.reloadAsync(!b.pending)
+	case 'r':
+		b.reloadAsync(b.pending)
+	case 'e':
+		b.export("json")
+	case 'y':
+		b.export("yaml")
+	default:
+		return event
+	}
+
+	return nil
+}
+
+func (b *Browser) focus(direction int) {
+	current := b.app.GetFocus()
+
+	for i, pane := range b.panes {
+		if pane == current {
+			next := (i + direction + len(b.panes)) % len(b.panes)
+			b.app.SetFocus(b.panes[next])
+			return
+		}
+	}
+
+	b.app.SetFocus(b.scopes)
+}
+
+func (b *Browser) reload() error {
+	list, err := b.load(b.ctx, b.cluster)
+	if err != nil {
+		return err
+	}
+
+	b.results = list.Items
+	b.reloadFromResults()
+
+	return nil
+}
+
+// reloadAsync loads the results of the given scope in the background, the current results are shown until they are replaced
+func (b *Browser) reloadAsync(cluster bool) {
+	b.setStatus("[yellow]Loading results...")
+
+	b.pending = cluster
+
+	go func() {
+		list, err := b.load(b.ctx, cluster)
+
+		b.app.QueueUpdateDraw(func() {
+			// the scope was toggled while loading, the results of the new scope are loaded by the toggle
+			if cluster != b.pending {
+				return
+			}
+
+			if err != nil {
+				b.pending = b.cluster
+				b.setStatus(fmt.Sprintf("[red]Unable to load results: %s", tview.Escape(err.Error())))
+				return
+			}
+
+			b.cluster = cluster
+			b.results = list.Items
+			b.reloadFromResults()
+			b.setStatus(help)
+		})
+	}()
+}
+
+func (b *Browser) reloadFromResults() {
+	sort.SliceStable(b.results, func(i, j int) bool {
+		return resourceKey(b.results[i]) < resourceKey(b.results[j])
+	})
+
+	b.scope = allEntries
+	b.resource = allEntries
+
+	if b.cluster {
+		b.scopes.SetTitle(" Kinds ")
+	} else {
+		b.scopes.SetTitle(" Namespaces ")
+	}
+
+	b.renderScopes()
+}
+
+func (b *Browser) renderScopes() {
+	counts := make(map[string]int)
+	for _, result := range b.filtered() {
+		counts[b.scopeOf(result)] += failing(result)
+		counts[allEntries] += failing(result)
+	}
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		if key != allEntries {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	b.scopeKeys = append([]string{allEntries}, keys...)
+	b.rebuildList(b.scopes, b.scopeKeys, counts, &b.scope)
+
+	b.renderResources()
+}
+
+func (b *Browser) renderResources() {
+	counts := make(map[string]int)
+	for _, result := range b.filtered() {
+		if b.scope != allEntries && b.scopeOf(result) != b.scope {
+			continue
+		}
+
+		counts[resourceKey(result)] += failing(result)
+		counts[allEntries] += failing(result)
+	}
+
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		if key != allEntries {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	b.resourceKeys = append([]string{allEntries}, keys...)
+	b.rebuildList(b.resources, b.resourceKeys, counts, &b.resource)
+
+	b.renderResults()
+}
+
+func (b *Browser) rebuildList(list *tview.List, keys []string, counts map[string]int, selected *string) {
+	b.rebuilding = true
+	defer func() { b.rebuilding = false }()
+
+	list.Clear()
+
+	current := 0
+	for i, key := range keys {
+		label := tview.Escape(key)
+		if counts[key] > 0 {
+			label = fmt.Sprintf("%s [red](%d)[-]", label, counts[key])
+		}
+
+		list.AddItem(label, "", 0, nil)
+
+		if key == *selected {
+			current = i
+		}
+	}
+
+	list.SetCurrentItem(current)
+	*selected = keys[current]
+}
+
+func (b *Browser) renderResults() {
+	b.view = make([]policyreporter.PolicyReportResult, 0)
+	for _, result := range b.filtered() {
+		if b.scope != allEntries && b.scopeOf(result) != b.scope {
+			continue
+		}
+		if b.resource != allEntries && resourceKey(result) != b.resource {
+			continue
+		}
+
+		b.view = append(b.view, result)
+	}
+
+	columns := []string{"NAMESPACE", "KIND", "NAME", "POLICY", "RULE", "SEVERITY", "RESULT"}
+	if b.cluster {
+		columns = columns[1:]
+	}
+
+	b.table.Clear()
+	for i, column := range columns {
+		b.table.SetCell(0, i, tview.NewTableCell(column).SetSelectable(false).SetAttributes(tcell.AttrBold))
+	}
+
+	for row, result := range b.view {
+		values := []string{result.Namespace, result.Kind, result.Name, result.Policy, result.Rule, result.Severity, fmt.Sprintf("[%s]%s", statusColors[result.Status], result.Status)}
+		if b.cluster {
+			values = values[1:]
+		}
+
+		for i, value := range values {
+			if i < len(values)-1 {
+				value = tview.Escape(value)
+			}
+
+			b.table.SetCell(row+1, i, tview.NewTableCell(value).SetExpansion(1))
+		}
+	}
+
+	b.table.SetTitle(fmt.Sprintf(" Results (%d) ", len(b.view)))
+	b.table.ScrollToBeginning()
+	b.table.Select(1, 0)
+	b.renderDetails(0)
+}
+
+func (b *Browser) renderDetails(index int) {
+	if index < 0 || index >= len(b.view) {
+		b.details.SetText("")
+		return
+	}
+
+	result := b.view[index]
+
+	var text strings.Builder
+
+	field := func(name, value string) {
+		if value != "" {
+			text.WriteString(fmt.Sprintf("[::b]%s:[::-] %s\n", name, tview.Escape(value)))
+		}
+	}
+
+	field("Resource", resourceKey(result))
+	field("API Version", result.APIVersion)
+	field("Policy", result.Policy)
+	field("Rule", result.Rule)
+	text.WriteString(fmt.Sprintf("[::b]Result:[::-] [%s]%s[-]\n", statusColors[result.Status], result.Status))
+	field("Severity", result.Severity)
+	field("Category", result.Category)
+	field("Source", result.Source)
+	field("Created", result.TimeFormatted)
+	field("Message", result.Message)
+
+	if len(result.Properties) > 0 {
+		text.WriteString("[::b]Properties:[::-]\n")
+
+		keys := make([]string, 0, len(result.Properties))
+		for key := range result.Properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			text.WriteString(fmt.Sprintf("  %s: %s\n", tview.Escape(key), tview.Escape(result.Properties[key])))
+		}
+	}
+
+	b.details.SetText(text.String()).ScrollToBeginning()
+}
+
+func (b *Browser) export(format string) {
+	name := fmt.Sprintf("polr-export-%s.%s", time.Now().Format("20060102-150405"), format)
+
+	prn, err := klo.PrinterFromFlag(format, nil)
+	if err != nil {
+		b.setStatus(fmt.Sprintf("[red]%s", tview.Escape(err.Error())))
+		return
+	}
+
+	file, err := os.Create(name)
+	if err != nil {
+		b.setStatus(fmt.Sprintf("[red]Unable to export results: %s", tview.Escape(err.Error())))
+		return
+	}
+	defer file.Close()
+
+	if err := prn.Fprint(file, b.view); err != nil {
+		b.setStatus(fmt.Sprintf("[red]Unable to export results: %s", tview.Escape(err.Error())))
+		return
+	}
+
+	b.setStatus(fmt.Sprintf("[green]Exported %d results to %s", len(b.view), name))
+}
+
+func (b *Browser) setStatus(text string) {
+	b.status.SetText(text)
+}
+
+func (b *Browser) filtered() []policyreporter.PolicyReportResult {
+	if b.query == "" {
+		return b.results
+	}
+
+	list := make([]policyreporter.PolicyReportResult, 0, len(b.results))
+	for _, result := range b.results {
+		if matches(result, strings.Fields(b.query)) {
+			list = append(list, result)
+		}
+	}
+
+	return list
+}
+
+func (b *Browser) scopeOf(result policyreporter.PolicyReportResult) string {
+	if b.cluster {
+		return result.Kind
+	}
+
+	return result.Namespace
+}
+
+// matches is true if each term is part of any result field or property
+func matches(result policyreporter.PolicyReportResult, terms []string) bool {
+	values := []string{result.Namespace, result.Kind, result.Name, result.Policy, result.Rule, result.Status, result.Severity, result.Category, result.Source, result.Message}
+	for _, value := range result.Properties {
+		values = append(values, value)
+	}
+
+	content := strings.ToLower(strings.Join(values, "\n"))
+
+	for _, term := range terms {
+		if !strings.Contains(content, term) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func failing(result policyreporter.PolicyReportResult) int {
+	if result.Status == policyreporter.Fail || result.Status == policyreporter.Error {
+		return 1
+	}
+
+	return 0
+}
+
+func resourceKey(result policyreporter.PolicyReportResult) string {
+	if result.Namespace == "" {
+		return fmt.Sprintf("%s/%s", result.Kind, result.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", result.Namespace, result.Kind, result.Name)
+}
+
+// NewBrowser creates a new Browser, starting with namespaced or cluster scoped results
+func NewBrowser(ctx context.Context, load LoadFunc, cluster bool) *Browser {
+	return &Browser{ctx: ctx, load: load, cluster: cluster, pending: cluster}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/model"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// PropertyKeys returns the sorted, unique property keys of all results
+func PropertyKeys(results []policyreporter.PolicyReportResult) []string {
+	unique := make(map[string]bool)
+	keys := make([]string, 0)
+
+	for _, result := range results {
+		for key := range result.Properties {
+			if !unique[key] {
+				unique[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// PropertyColumns returns a custom column spec with a column for each property key, starting with a comma.
+// Keys which can not be used in a column spec are skipped.
+func PropertyColumns(keys []string) string {
+	var spec strings.Builder
+
+	for _, key := range keys {
+		if strings.ContainsAny(key, ",{}\\") {
+			continue
+		}
+
+		header := strings.ToUpper(strings.ReplaceAll(key, ":", "_"))
+		spec.WriteString(fmt.Sprintf(",%s:{.Properties.%s}", header, strings.ReplaceAll(key, ".", `\.`)))
+	}
+
+	return spec.String()
+}
+
+// GroupResultsByProperty returns a GroupingFunc for the value of the property key
+func GroupResultsByProperty(key string) GroupingFunc {
+	return func(results []policyreporter.PolicyReportResult) []*model.Group {
+		return groupResultsBy(results, func(r policyreporter.PolicyReportResult) string { return r.Properties[key] }, fmt.Sprintf("No %s", key), sort.Strings)
+	}
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/cli"
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// SortResults sorts the results in place, a "-" prefix reverses the order.
+// Without sorting, results are ordered by namespace, kind, name, policy and rule.
+func SortResults(results []policyreporter.PolicyReportResult, sorting cli.Sorting) error {
+	if sorting == "" {
+		sort.SliceStable(results, func(i, j int) bool {
+			return lessResource(results[i], results[j])
+		})
+
+		return nil
+	}
+
+	reverse := strings.HasPrefix(sorting, "-")
+
+	var less func(a, b policyreporter.PolicyReportResult) bool
+
+	switch strings.TrimPrefix(sorting, "-") {
+	case cli.TimestampSorting:
+		less = func(a, b policyreporter.PolicyReportResult) bool { return a.Timestamp < b.Timestamp }
+	default:
+		return fmt.Errorf("unsupported sorting %q, expected one of: %s", sorting, cli.TimestampSorting)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if reverse {
+			return less(results[j], results[i])
+		}
+
+		return less(results[i], results[j])
+	})
+
+	return nil
+}
+
+func lessResource(a, b policyreporter.PolicyReportResult) bool {
+	for _, values := range [][2]string{
+		{a.Namespace, b.Namespace},
+		{a.Kind, b.Kind},
+		{a.Name, b.Name},
+		{a.Policy, b.Policy},
+		{a.Rule, b.Rule},
+	} {
+		if values[0] != values[1] {
+			return values[0] < values[1]
+		}
+	}
+
+	return false
+}
+
+var severityOrder = map[string]int{policyreporter.High: 0, policyreporter.Medium: 1, policyreporter.Low: 2}
+
+// SortSeverities sorts the severities in place from high to low, unknown severities are sorted alphabetically after them and empty severities last
+func SortSeverities(severities []string) {
+	rank := func(severity string) int {
+		if value, ok := severityOrder[severity]; ok {
+			return value
+		} else if severity == "" {
+			return len(severityOrder) + 1
+		}
+
+		return len(severityOrder)
+	}
+
+	sort.SliceStable(severities, func(i, j int) bool {
+		a, b := rank(severities[i]), rank(severities[j])
+		if a == b {
+			return severities[i] < severities[j]
+		}
+
+		return a < b
+	})
+}
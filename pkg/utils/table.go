@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/kyverno/policy-reporter-cli/pkg/model"
@@ -126,9 +127,16 @@ func GroupResultsByResource(results []policyreporter.PolicyReportResult) []*mode
 		}
 	}
 
+	keys := make([]string, 0, len(groups))
+	for s := range groups {
+		keys = append(keys, s)
+	}
+
+	sort.Strings(keys)
+
 	result := []*model.Group{}
 
-	for s := range groups {
+	for _, s := range keys {
 		result = append(result, groups[s])
 	}
 
@@ -143,3 +151,83 @@ func NoneGrouping(results []policyreporter.PolicyReportResult) []*model.Group {
 		},
 	}
 }
+
+// GroupingFunc splits results into groups
+type GroupingFunc = func(results []policyreporter.PolicyReportResult) []*model.Group
+
+func GroupResultsByNamespace(results []policyreporter.PolicyReportResult) []*model.Group {
+	return groupResultsBy(results, func(r policyreporter.PolicyReportResult) string { return r.Namespace }, "Cluster Scoped", sort.Strings)
+}
+
+func GroupResultsBySeverity(results []policyreporter.PolicyReportResult) []*model.Group {
+	return groupResultsBy(results, func(r policyreporter.PolicyReportResult) string { return r.Severity }, "No Severity", SortSeverities)
+}
+
+func GroupResultsBySource(results []policyreporter.PolicyReportResult) []*model.Group {
+	return groupResultsBy(results, func(r policyreporter.PolicyReportResult) string { return r.Source }, "No Source", sort.Strings)
+}
+
+func GroupResultsByKind(results []policyreporter.PolicyReportResult) []*model.Group {
+	return groupResultsBy(results, func(r policyreporter.PolicyReportResult) string { return r.Kind }, "No Kind", sort.Strings)
+}
+
+func GroupResultsByRule(results []policyreporter.PolicyReportResult) []*model.Group {
+	return groupResultsBy(results, func(r policyreporter.PolicyReportResult) string { return fmt.Sprintf("%s/%s", r.Policy, r.Rule) }, "No Rule", sort.Strings)
+}
+
+// groupResultsBy groups the results by the key, groups with an empty key are labeled with the fallback
+func groupResultsBy(results []policyreporter.PolicyReportResult, key func(policyreporter.PolicyReportResult) string, fallback string, sortKeys func([]string)) []*model.Group {
+	groups := make(map[string]*model.Group, 0)
+	keys := make([]string, 0)
+
+	for _, result := range results {
+		k := key(result)
+		if group, ok := groups[k]; ok {
+			group.List = append(group.List, result)
+			continue
+		}
+
+		label := k
+		if label == "" {
+			label = fallback
+		}
+
+		groups[k] = &model.Group{Label: label, List: []policyreporter.PolicyReportResult{result}}
+		keys = append(keys, k)
+	}
+
+	sortKeys(keys)
+
+	list := make([]*model.Group, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, groups[k])
+	}
+
+	return list
+}
+
+// NestedGrouping applies each grouping to the groups of the previous one, labels are joined with " / "
+func NestedGrouping(results []policyreporter.PolicyReportResult, groupings []GroupingFunc) []*model.Group {
+	groups := NoneGrouping(results)
+
+	for _, grouping := range groupings {
+		nested := make([]*model.Group, 0, len(groups))
+
+		for _, group := range groups {
+			for _, sub := range grouping(group.List) {
+				label := sub.Label
+				if group.Label != "" && label != "" {
+					label = fmt.Sprintf("%s / %s", group.Label, label)
+				} else if label == "" {
+					label = group.Label
+				}
+
+				nested = append(nested, &model.Group{Label: label, List: sub.List})
+			}
+		}
+
+		groups = nested
+	}
+
+	return groups
+}
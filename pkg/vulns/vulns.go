@@ -0,0 +1,390 @@
+package vulns
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+)
+
+// Views of the vulnerabilities
+const (
+	ImageView = "image"
+	CVEView   = "cve"
+	NoneView  = "none"
+)
+
+// Sortings of the vulnerabilities
+const (
+	SeveritySorting = "severity"
+	FixableSorting  = "fixable"
+	ScoreSorting    = "score"
+	CountSorting    = "count"
+)
+
+// UnknownImage is used for vulnerabilities without image properties
+const UnknownImage = "<unknown>"
+
+// property keys used by Trivy and other scanners, the first existing key is used
+var (
+	idKeys        = []string{"resultID", "vulnerabilityID", "vulnerabilityId", "cve", "id"}
+	packageKeys   = []string{"pkgName", "package", "pkg.name", "resource"}
+	installedKeys = []string{"installedVersion", "installed_version", "version"}
+	fixedKeys     = []string{"fixedVersion", "fixed_version", "fixVersion"}
+	scoreKeys     = []string{"score", "cvssScore", "cvss"}
+	imageKeys     = []string{"image", "imageRef"}
+)
+
+var severityRanks = map[string]int{
+	"critical": 5,
+	"high":     4,
+	"medium":   3,
+	"low":      2,
+	"info":     1,
+}
+
+// Vulnerability parsed from the properties of a PolicyReportResult
+type Vulnerability struct {
+	Namespace        string  `json:"namespace,omitempty"`
+	Kind             string  `json:"kind"`
+	Name             string  `json:"name"`
+	Image            string  `json:"image"`
+	ID               string  `json:"id"`
+	Severity         string  `json:"severity"`
+	Package          string  `json:"package"`
+	InstalledVersion string  `json:"installedVersion"`
+	FixedVersion     string  `json:"fixedVersion"`
+	Score            float64 `json:"score"`
+	Fixable          bool    `json:"fixable"`
+	Title            string  `json:"title"`
+}
+
+// Parse reads the vulnerability details from the properties of the result,
+// the policy and rule are used as fallback for the ID and package
+func Parse(result policyreporter.PolicyReportResult) Vulnerability {
+	v := Vulnerability{
+		Namespace:        result.Namespace,
+		Kind:             result.Kind,
+		Name:             result.Name,
+		Image:            image(result.Properties),
+		ID:               lookup(result.Properties, idKeys, result.Policy),
+		Severity:         strings.ToLower(result.Severity),
+		Package:          lookup(result.Properties, packageKeys, result.Rule),
+		InstalledVersion: lookup(result.Properties, installedKeys, ""),
+		FixedVersion:     lookup(result.Properties, fixedKeys, ""),
+		Title:            result.Message,
+	}
+
+	if v.Severity == "" {
+		v.Severity = strings.ToLower(result.Properties["severity"])
+	}
+
+	v.Score, _ = strconv.ParseFloat(lookup(result.Properties, scoreKeys, ""), 64)
+	v.Fixable = v.FixedVersion != ""
+
+	return v
+}
+
+// ParseAll parses all results
+func ParseAll(results []policyreporter.PolicyReportResult) []Vulnerability {
+	list := make([]Vulnerability, 0, len(results))
+	for _, result := range results {
+		list = append(list, Parse(result))
+	}
+
+	return list
+}
+
+func lookup(properties map[string]string, keys []string, fallback string) string {
+	for _, key := range keys {
+		if value := properties[key]; value != "" {
+			return value
+		}
+	}
+
+	return fallback
+}
+
+// image returns the image property or builds it from the registry and artifact properties of the trivy-operator
+func image(properties map[string]string) string {
+	if value := lookup(properties, imageKeys, ""); value != "" {
+		return value
+	}
+
+	repository := properties["artifact.repository"]
+	if repository == "" {
+		return UnknownImage
+	}
+
+	if registry := properties["registry.server"]; registry != "" {
+		repository = fmt.Sprintf("%s/%s", registry, repository)
+	}
+
+	if tag := properties["artifact.tag"]; tag != "" {
+		repository = fmt.Sprintf("%s:%s", repository, tag)
+	}
+
+	return repository
+}
+
+// SeverityRank orders severities from critical to info, unknown severities are ranked lowest
+func SeverityRank(severity string) int {
+	return severityRanks[strings.ToLower(severity)]
+}
+
+// Image aggregates the unique vulnerabilities of a single image, Score is the highest score of all vulnerabilities
+type Image struct {
+	Image     string  `json:"image"`
+	Critical  int     `json:"critical"`
+	High      int     `json:"high"`
+	Medium    int     `json:"medium"`
+	Low       int     `json:"low"`
+	Other     int     `json:"other"`
+	Total     int     `json:"total"`
+	Fixable   int     `json:"fixable"`
+	Score     float64 `json:"score"`
+	Resources int     `json:"resources"`
+}
+
+// CVE aggregates all findings of a single vulnerability
+type CVE struct {
+	ID            string   `json:"id"`
+	Severity      string   `json:"severity"`
+	Score         float64  `json:"score"`
+	Fixable       bool     `json:"fixable"`
+	Packages      []string `json:"packages"`
+	FixedVersions []string `json:"fixedVersions"`
+	Images        int      `json:"images"`
+	Resources     int      `json:"resources"`
+	Title         string   `json:"title"`
+}
+
+// ByImage counts the unique vulnerabilities per image, a vulnerability is identified by its ID and package
+func ByImage(list []Vulnerability) []Image {
+	images := make(map[string]*Image)
+	seen := make(map[string]bool)
+	resources := make(map[string]map[string]bool)
+	keys := make([]string, 0)
+
+	for _, v := range list {
+		item, ok := images[v.Image]
+		if !ok {
+			item = &Image{Image: v.Image}
+			images[v.Image] = item
+			resources[v.Image] = make(map[string]bool)
+			keys = append(keys, v.Image)
+		}
+
+		resources[v.Image][resourceKey(v)] = true
+
+		if v.Score > item.Score {
+			item.Score = v.Score
+		}
+
+		key := fmt.Sprintf("%s|%s|%s", v.Image, v.ID, v.Package)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		item.Total++
+		if v.Fixable {
+			item.Fixable++
+		}
+
+		switch SeverityRank(v.Severity) {
+		case 5:
+			item.Critical++
+		case 4:
+			item.High++
+		case 3:
+			item.Medium++
+		case 2:
+			item.Low++
+		default:
+			item.Other++
+		}
+	}
+
+	result := make([]Image, 0, len(keys))
+	for _, key := range keys {
+		images[key].Resources = len(resources[key])
+		result = append(result, *images[key])
+	}
+
+	return result
+}
+
+// ByCVE aggregates the packages, fixed versions, images and resources per vulnerability
+func ByCVE(list []Vulnerability) []CVE {
+	cves := make(map[string]*CVE)
+	images := make(map[string]map[string]bool)
+	resources := make(map[string]map[string]bool)
+	keys := make([]string, 0)
+
+	for _, v := range list {
+		item, ok := cves[v.ID]
+		if !ok {
+			item = &CVE{ID: v.ID, Severity: v.Severity, Title: v.Title}
+			cves[v.ID] = item
+			images[v.ID] = make(map[string]bool)
+			resources[v.ID] = make(map[string]bool)
+			keys = append(keys, v.ID)
+		}
+
+		if SeverityRank(v.Severity) > SeverityRank(item.Severity) {
+			item.Severity = v.Severity
+		}
+
+		if v.Score > item.Score {
+			item.Score = v.Score
+		}
+
+		item.Fixable = item.Fixable || v.Fixable
+		item.Packages = appendUnique(item.Packages, v.Package)
+		item.FixedVersions = appendUnique(item.FixedVersions, v.FixedVersion)
+
+		images[v.ID][v.Image] = true
+		resources[v.ID][resourceKey(v)] = true
+	}
+
+	result := make([]CVE, 0, len(keys))
+	for _, key := range keys {
+		item := cves[key]
+		item.Images = len(images[key])
+		item.Resources = len(resources[key])
+
+		sort.Strings(item.Packages)
+		sort.Strings(item.FixedVersions)
+
+		result = append(result, *item)
+	}
+
+	return result
+}
+
+// SortVulnerabilities sorts by the given sorting, ties are ordered by severity, score and ID.
+// CountSorting is not supported because each vulnerability is a single finding.
+func SortVulnerabilities(list []Vulnerability, sorting string) error {
+	if sorting == CountSorting {
+		return fmt.Errorf("unsupported sorting %q for view %s, expected one of: %s, %s, %s", sorting, NoneView, SeveritySorting, FixableSorting, ScoreSorting)
+	}
+
+	less, err := comparator(sorting,
+		func(i int) []float64 { return []float64{float64(SeverityRank(list[i].Severity)), list[i].Score} },
+		func(i int) float64 { return boolValue(list[i].Fixable) },
+		func(i int) float64 { return list[i].Score },
+		nil,
+		func(i int) string {
+			return fmt.Sprintf("%s|%s|%s/%s/%s", list[i].ID, list[i].Image, list[i].Namespace, list[i].Kind, list[i].Name)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(list, less)
+
+	return nil
+}
+
+// SortImages sorts by the given sorting, ties are ordered by the image name
+func SortImages(list []Image, sorting string) error {
+	less, err := comparator(sorting,
+		func(i int) []float64 {
+			return []float64{float64(list[i].Critical), float64(list[i].High), float64(list[i].Medium), float64(list[i].Low)}
+		},
+		func(i int) float64 { return float64(list[i].Fixable) },
+		func(i int) float64 { return list[i].Score },
+		func(i int) float64 { return float64(list[i].Total) },
+		func(i int) string { return list[i].Image },
+	)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(list, less)
+
+	return nil
+}
+
+// SortCVEs sorts by the given sorting, ties are ordered by the ID
+func SortCVEs(list []CVE, sorting string) error {
+	less, err := comparator(sorting,
+		func(i int) []float64 { return []float64{float64(SeverityRank(list[i].Severity)), list[i].Score} },
+		func(i int) float64 { return boolValue(list[i].Fixable) },
+		func(i int) float64 { return list[i].Score },
+		func(i int) float64 { return float64(list[i].Resources) },
+		func(i int) string { return list[i].ID },
+	)
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(list, less)
+
+	return nil
+}
+
+// comparator creates a less function which orders the highest values of the sorting first,
+// ties are ordered by the severity values and finally by name. count may be nil if CountSorting is rejected by the caller
+func comparator(
+	sorting string,
+	severity func(int) []float64,
+	fixable, score, count func(int) float64,
+	name func(int) string,
+) (func(i, j int) bool, error) {
+	var primary func(int) []float64
+
+	switch sorting {
+	case SeveritySorting, "":
+		primary = severity
+	case FixableSorting:
+		primary = func(i int) []float64 { return append([]float64{fixable(i)}, severity(i)...) }
+	case ScoreSorting:
+		primary = func(i int) []float64 { return append([]float64{score(i)}, severity(i)...) }
+	case CountSorting:
+		primary = func(i int) []float64 { return append([]float64{count(i)}, severity(i)...) }
+	default:
+		return nil, fmt.Errorf("unsupported sorting %q, expected one of: %s, %s, %s, %s", sorting, SeveritySorting, FixableSorting, ScoreSorting, CountSorting)
+	}
+
+	return func(i, j int) bool {
+		a, b := primary(i), primary(j)
+		for k := range a {
+			if a[k] != b[k] {
+				return a[k] > b[k]
+			}
+		}
+
+		return name(i) < name(j)
+	}, nil
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+
+func appendUnique(list []string, value string) []string {
+	if value == "" {
+		return list
+	}
+
+	for _, item := range list {
+		if item == value {
+			return list
+		}
+	}
+
+	return append(list, value)
+}
+
+func resourceKey(v Vulnerability) string {
+	return fmt.Sprintf("%s/%s/%s", v.Namespace, v.Kind, v.Name)
+}
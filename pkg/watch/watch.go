@@ -0,0 +1,161 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kyverno/policy-reporter-cli/pkg/policyreporter"
+	"github.com/mattn/go-isatty"
+	"github.com/ttacon/chalk"
+)
+
+// DefaultInterval between two API requests
+const DefaultInterval = 5 * time.Second
+
+// FetchFunc requests the current results from the API
+type FetchFunc = func(context.Context) (policyreporter.ResultList, error)
+
+// RenderFunc prints the complete result list
+type RenderFunc = func(policyreporter.ResultList) error
+
+// Changes between two fetched result lists
+type Changes struct {
+	Added    []policyreporter.PolicyReportResult
+	Resolved []policyreporter.PolicyReportResult
+	Changed  []policyreporter.PolicyReportResult
+}
+
+// Empty is true if nothing changed
+func (c Changes) Empty() bool {
+	return len(c.Added) == 0 && len(c.Resolved) == 0 && len(c.Changed) == 0
+}
+
+// Diff compares the previous with the current results
+func Diff(previous, current []policyreporter.PolicyReportResult) Changes {
+	changes := Changes{}
+
+	before := make(map[string]policyreporter.PolicyReportResult, len(previous))
+	for _, result := range previous {
+		before[key(result)] = result
+	}
+
+	after := make(map[string]struct{}, len(current))
+	for _, result := range current {
+		k := key(result)
+		after[k] = struct{}{}
+
+		old, ok := before[k]
+		if !ok {
+			changes.Added = append(changes.Added, result)
+		} else if old.Status != result.Status {
+			changes.Changed = append(changes.Changed, result)
+		}
+	}
+
+	for _, result := range previous {
+		if _, ok := after[key(result)]; !ok {
+			changes.Resolved = append(changes.Resolved, result)
+		}
+	}
+
+	return changes
+}
+
+// Run polls the results every interval until the context is canceled or rendering the results fails.
+// In a terminal the output is redrawn with highlighted changes, otherwise only a change log is printed after the initial list.
+func Run(ctx context.Context, interval time.Duration, fetch FetchFunc, render RenderFunc) error {
+	w := os.Stdout
+	tty := isatty.IsTerminal(w.Fd())
+
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var previous []policyreporter.PolicyReportResult
+	first := true
+
+	for {
+		results, err := fetch(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[ERROR] Unable to fetch results: %s\n", err)
+		} else {
+			changes := Diff(previous, results.Items)
+
+			if tty {
+				fmt.Fprint(w, "\033[H\033[2J")
+				fmt.Fprintf(w, "Every %s: %s\n\n", interval, time.Now().Format(time.RFC3339))
+				if err := render(results); err != nil {
+					return err
+				}
+
+				if !first && !changes.Empty() {
+					fmt.Fprintln(w, "")
+					fmt.Fprintln(w, chalk.Bold.TextStyle("Changes"))
+					printChanges(w, changes, true)
+				}
+			} else if first {
+				if err := render(results); err != nil {
+					return err
+				}
+			} else {
+				printChanges(w, changes, false)
+			}
+
+			previous = results.Items
+			first = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// printChanges prints each change, added results are highlighted as regressions and resolved results as improvements
+func printChanges(w io.Writer, changes Changes, colored bool) {
+	timestamp := time.Now().Format(time.RFC3339)
+
+	printLine := func(action string, color chalk.Color, result policyreporter.PolicyReportResult) {
+		line := fmt.Sprintf("%-8s %s %s/%s %s", action, resource(result), result.Policy, result.Rule, result.Status)
+		if colored {
+			fmt.Fprintln(w, color.Color(line))
+			return
+		}
+
+		fmt.Fprintf(w, "%s %s\n", timestamp, line)
+	}
+
+	for _, result := range changes.Added {
+		printLine("ADDED", chalk.Red, result)
+	}
+	for _, result := range changes.Changed {
+		printLine("CHANGED", chalk.Yellow, result)
+	}
+	for _, result := range changes.Resolved {
+		printLine("RESOLVED", chalk.Green, result)
+	}
+}
+
+func resource(result policyreporter.PolicyReportResult) string {
+	if result.Namespace == "" {
+		return fmt.Sprintf("%s/%s", result.Kind, result.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", result.Namespace, result.Kind, result.Name)
+}
+
+func key(result policyreporter.PolicyReportResult) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", result.Namespace, result.APIVersion, result.Kind, result.Name, result.Policy, result.Rule)
+}